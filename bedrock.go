@@ -7,7 +7,7 @@ package bedrock
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"os"
 	"os/signal"
 	"sync"
@@ -109,8 +109,19 @@ func (f RunnerFunc[T]) Run(ctx context.Context, builder Builder[T]) error {
 }
 
 // DefaultRunner returns a Runner that builds and runs the application component.
+//
+// Release functions registered with [OnCleanup] while building are run in reverse
+// construction order if the build fails, or once the Runtime returns. Errors from
+// releasing are joined with the build or run error.
 func DefaultRunner[T Runtime]() Runner[T] {
-	return RunnerFunc[T](func(ctx context.Context, builder Builder[T]) error {
+	return RunnerFunc[T](func(ctx context.Context, builder Builder[T]) (err error) {
+		ctx, release := WithCleanup(ctx)
+		defer func() {
+			if rerr := release(context.WithoutCancel(ctx)); rerr != nil {
+				err = errors.Join(err, rerr)
+			}
+		}()
+
 		app, err := builder.Build(ctx)
 		if err != nil {
 			return err
@@ -129,12 +140,12 @@ func NotifyOnSignal[T Runtime](runner Runner[T], signals ...os.Signal) Runner[T]
 	})
 }
 
-// RecoverPanics wraps a Runner to recover from panics during execution and return them as errors.
+// RecoverPanics wraps a Runner to recover from panics during execution and return them as a *PanicError.
 func RecoverPanics[T Runtime](runner Runner[T]) Runner[T] {
 	return RunnerFunc[T](func(ctx context.Context, builder Builder[T]) (err error) {
 		defer func() {
 			if r := recover(); r != nil {
-				err = fmt.Errorf("recovered from panic: %v", r)
+				err = NewPanicError(r)
 			}
 		}()
 		return runner.Run(ctx, builder)
@@ -0,0 +1,211 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrocktest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/z5labs/bedrock"
+)
+
+// Option configures [Start].
+type Option func(*options)
+
+type options struct {
+	readyTimeout    time.Duration
+	shutdownTimeout time.Duration
+	waitReady       bool
+	checkLeaks      bool
+	ignore          []string
+}
+
+// ReadyTimeout sets how long Start waits for the Runtime to report that it is ready.
+// The default is 5 seconds.
+func ReadyTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.readyTimeout = d
+	}
+}
+
+// ShutdownTimeout sets how long the Runtime may take to return once stopped, and
+// how long its goroutines may take to exit after that. The default is 5 seconds.
+func ShutdownTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.shutdownTimeout = d
+	}
+}
+
+// WithoutReady makes Start return as soon as the Runtime has been built, for
+// Runtimes which never report that they are ready.
+func WithoutReady() Option {
+	return func(o *options) {
+		o.waitReady = false
+	}
+}
+
+// SkipLeakCheck disables checking for leaked goroutines, e.g. for tests run with
+// t.Parallel, whose goroutines cannot be told apart from those of the Runtime.
+func SkipLeakCheck() Option {
+	return func(o *options) {
+		o.checkLeaks = false
+	}
+}
+
+// IgnoreGoroutines ignores leaked goroutines whose stack contains any of the given
+// function names, e.g. "net/http.(*persistConn).readLoop" for idle HTTP client
+// connections.
+func IgnoreGoroutines(funcs ...string) Option {
+	return func(o *options) {
+		o.ignore = append(o.ignore, funcs...)
+	}
+}
+
+// App is a Runtime started in the background by [Start].
+type App[T bedrock.Runtime] struct {
+	runtime T
+	opts    options
+
+	cancel context.CancelFunc
+	errCh  chan error
+
+	once sync.Once
+	err  error
+}
+
+// Start builds the application with builder and runs it in the background until the
+// test finishes. The application is run by a bedrock.DefaultRunner wrapped with
+// bedrock.RecoverPanics, so release functions registered with bedrock.OnCleanup run
+// once it returns.
+//
+// Start waits until the Runtime reports that it is ready, see bedrock.Ready, and fails
+// the test if the application fails to build, returns or does not become ready in time.
+//
+// Once the test finishes the Runtime is stopped by cancelling its context. The test
+// fails unless it returns nil, or an error wrapping context.Canceled, within the
+// shutdown timeout, and unless every goroutine started since Start has exited by then.
+func Start[T bedrock.Runtime](t testing.TB, builder bedrock.Builder[T], opts ...Option) *App[T] {
+	t.Helper()
+
+	o := options{
+		readyTimeout:    5 * time.Second,
+		shutdownTimeout: 5 * time.Second,
+		waitReady:       true,
+		checkLeaks:      true,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var before map[uint64]struct{}
+	if o.checkLeaks {
+		before = goroutineIDs()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx, ready := bedrock.NotifyReady(ctx)
+
+	app := &App[T]{
+		opts:   o,
+		cancel: cancel,
+		errCh:  make(chan error, 1),
+	}
+
+	built := make(chan struct{})
+	capture := bedrock.BuilderFunc[T](func(ctx context.Context) (T, error) {
+		rt, err := builder.Build(ctx)
+		if err == nil {
+			app.runtime = rt
+			close(built)
+		}
+		return rt, err
+	})
+
+	go func() {
+		app.errCh <- bedrock.RecoverPanics(bedrock.DefaultRunner[T]()).Run(ctx, capture)
+	}()
+
+	t.Cleanup(func() {
+		t.Helper()
+
+		err := app.Stop()
+		if err != nil {
+			t.Errorf("bedrocktest: runtime did not shut down cleanly: %v", err)
+		}
+		if o.checkLeaks {
+			if leaked := leakedGoroutines(before, o.shutdownTimeout, o.ignore); len(leaked) > 0 {
+				t.Errorf("bedrocktest: found %d leaked goroutines:\n\n%s", len(leaked), joinStacks(leaked))
+			}
+		}
+	})
+
+	var started <-chan struct{} = built
+	if o.waitReady {
+		started = ready
+	}
+
+	timer := time.NewTimer(o.readyTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-started:
+		return app
+	case err := <-app.errCh:
+		// The failure is reported here, so there is nothing left for Stop to do.
+		app.once.Do(cancel)
+		if !isBuilt(built) {
+			t.Fatalf("bedrocktest: failed to build application: %v", err)
+		}
+		t.Fatalf("bedrocktest: runtime returned before it was ready: %v", err)
+	case <-timer.C:
+		t.Fatalf("bedrocktest: runtime was not ready within %s", o.readyTimeout)
+	}
+	return nil
+}
+
+func isBuilt(built <-chan struct{}) bool {
+	select {
+	case <-built:
+		return true
+	default:
+		return false
+	}
+}
+
+// Runtime returns the Runtime built by the application, e.g. to find the address
+// of an HTTP server listening on port 0.
+func (a *App[T]) Runtime() T {
+	return a.runtime
+}
+
+// Stop cancels the context of the Runtime and waits for it to return. It returns
+// the error returned by the Runtime, ignoring errors wrapping context.Canceled, or an
+// error if the Runtime does not return within the shutdown timeout.
+//
+// Stop is called automatically once the test finishes, so it only needs to be called
+// by tests which check what happens after the application has stopped. It is safe to
+// call multiple times.
+func (a *App[T]) Stop() error {
+	a.once.Do(func() {
+		a.cancel()
+
+		timer := time.NewTimer(a.opts.shutdownTimeout)
+		defer timer.Stop()
+
+		select {
+		case err := <-a.errCh:
+			if err != nil && !errors.Is(err, context.Canceled) {
+				a.err = err
+			}
+		case <-timer.C:
+			a.err = errors.New("bedrocktest: runtime did not return within " + a.opts.shutdownTimeout.String())
+		}
+	})
+	return a.err
+}
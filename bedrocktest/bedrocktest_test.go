@@ -0,0 +1,235 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrocktest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeT records the failures reported by Start instead of failing the test.
+type fakeT struct {
+	testing.TB
+
+	mu       sync.Mutex
+	errors   []string
+	fatal    bool
+	cleanups []func()
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Errorf(format string, args ...any) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *fakeT) Fatalf(format string, args ...any) {
+	t.Errorf(format, args...)
+
+	t.mu.Lock()
+	t.fatal = true
+	t.mu.Unlock()
+	runtime.Goexit()
+}
+
+func (t *fakeT) Cleanup(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cleanups = append(t.cleanups, f)
+}
+
+// run calls f like a test function, followed by its cleanups.
+func (t *fakeT) run(f func(t *fakeT)) {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		defer func() {
+			for i := len(t.cleanups) - 1; i >= 0; i-- {
+				t.cleanups[i]()
+			}
+		}()
+		f(t)
+	}()
+	<-done
+}
+
+func (t *fakeT) Errors() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.errors
+}
+
+// readyRuntime reports that it is ready and runs until its context is cancelled.
+func readyRuntime() bedrock.Runtime {
+	return bedrock.RuntimeFunc(func(ctx context.Context) error {
+		bedrock.Ready(ctx)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+}
+
+func TestStart(t *testing.T) {
+	t.Run("returns once the runtime is ready", func(t *testing.T) {
+		released := false
+		builder := bedrock.BuilderFunc[bedrock.Runtime](func(ctx context.Context) (bedrock.Runtime, error) {
+			bedrock.OnCleanup(ctx, func(ctx context.Context) error {
+				released = true
+				return nil
+			})
+			return readyRuntime(), nil
+		})
+
+		ft := &fakeT{}
+		ft.run(func(ft *fakeT) {
+			app := Start(ft, builder)
+			require.NotNil(t, app.Runtime())
+			require.False(t, released)
+		})
+		require.Empty(t, ft.Errors())
+		require.True(t, released)
+	})
+
+	t.Run("fails when the application fails to build", func(t *testing.T) {
+		ft := &fakeT{}
+		ft.run(func(ft *fakeT) {
+			Start(ft, bedrock.BuilderFunc[bedrock.Runtime](func(ctx context.Context) (bedrock.Runtime, error) {
+				return nil, errors.New("invalid config")
+			}))
+			t.Error("Start should not return")
+		})
+		require.True(t, ft.fatal)
+		require.Equal(t, []string{"bedrocktest: failed to build application: invalid config"}, ft.Errors())
+	})
+
+	t.Run("fails when the runtime returns before it is ready", func(t *testing.T) {
+		ft := &fakeT{}
+		ft.run(func(ft *fakeT) {
+			Start(ft, bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+				return errors.New("bind failed")
+			})))
+		})
+		require.Equal(t, []string{"bedrocktest: runtime returned before it was ready: bind failed"}, ft.Errors())
+	})
+
+	t.Run("fails when the runtime is not ready in time", func(t *testing.T) {
+		ft := &fakeT{}
+		ft.run(func(ft *fakeT) {
+			Start(ft, bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return nil
+			})), ReadyTimeout(10*time.Millisecond))
+		})
+		require.Equal(t, []string{"bedrocktest: runtime was not ready within 10ms"}, ft.Errors())
+	})
+
+	t.Run("returns once built without waiting for readiness", func(t *testing.T) {
+		ft := &fakeT{}
+		ft.run(func(ft *fakeT) {
+			Start(ft, bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return nil
+			})), WithoutReady())
+		})
+		require.Empty(t, ft.Errors())
+	})
+
+	t.Run("fails when the runtime does not shut down cleanly", func(t *testing.T) {
+		ft := &fakeT{}
+		ft.run(func(ft *fakeT) {
+			Start(ft, bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+				bedrock.Ready(ctx)
+				<-ctx.Done()
+				return errors.New("flush failed")
+			})))
+		})
+		require.Equal(t, []string{"bedrocktest: runtime did not shut down cleanly: flush failed"}, ft.Errors())
+	})
+
+	t.Run("fails when the runtime does not return in time", func(t *testing.T) {
+		stuck := make(chan struct{})
+		defer close(stuck)
+
+		ft := &fakeT{}
+		ft.run(func(ft *fakeT) {
+			Start(ft, bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+				bedrock.Ready(ctx)
+				<-stuck
+				return nil
+			})), ShutdownTimeout(10*time.Millisecond), SkipLeakCheck())
+		})
+		require.Equal(t, []string{"bedrocktest: runtime did not shut down cleanly: bedrocktest: runtime did not return within 10ms"}, ft.Errors())
+	})
+
+	t.Run("fails when goroutines are leaked", func(t *testing.T) {
+		leak := make(chan struct{})
+		defer close(leak)
+
+		ft := &fakeT{}
+		ft.run(func(ft *fakeT) {
+			Start(ft, bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+				go func() {
+					<-leak
+				}()
+				bedrock.Ready(ctx)
+				<-ctx.Done()
+				return nil
+			})), ShutdownTimeout(10*time.Millisecond))
+		})
+
+		errs := ft.Errors()
+		require.Len(t, errs, 1)
+		require.Contains(t, errs[0], "bedrocktest: found 1 leaked goroutines")
+		require.Contains(t, errs[0], "TestStart")
+	})
+
+	t.Run("ignores goroutines", func(t *testing.T) {
+		leak := make(chan struct{})
+		defer close(leak)
+
+		ft := &fakeT{}
+		ft.run(func(ft *fakeT) {
+			Start(ft, bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+				go waitForever(leak)
+				bedrock.Ready(ctx)
+				<-ctx.Done()
+				return nil
+			})), ShutdownTimeout(10*time.Millisecond), IgnoreGoroutines("bedrocktest.waitForever"))
+		})
+		require.Empty(t, ft.Errors())
+	})
+
+	t.Run("can be stopped by the test", func(t *testing.T) {
+		runErr := errors.New("flush failed")
+
+		ft := &fakeT{}
+		ft.run(func(ft *fakeT) {
+			app := Start(ft, bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+				bedrock.Ready(ctx)
+				<-ctx.Done()
+				return runErr
+			})))
+
+			require.Equal(t, runErr, app.Stop())
+			require.Equal(t, runErr, app.Stop())
+		})
+		require.Equal(t, []string{"bedrocktest: runtime did not shut down cleanly: flush failed"}, ft.Errors())
+	})
+}
+
+func waitForever(ch <-chan struct{}) {
+	<-ch
+}
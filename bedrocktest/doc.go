@@ -0,0 +1,29 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package bedrocktest runs bedrock applications in tests.
+//
+// Start builds and runs an application in the background, returning once it reports
+// that it is ready. The built Runtime is available from the returned App, e.g. to find
+// the address an HTTP server bound to port 0 is listening on:
+//
+//	func TestAPI(t *testing.T) {
+//	    app := bedrocktest.Start(t, http.Build(listener, handler))
+//
+//	    resp, err := http.Get("http://" + app.Runtime().Addr().String())
+//	    ...
+//	}
+//
+// Once the test finishes, the application is stopped and the test fails unless it
+// shuts down cleanly within the shutdown timeout.
+//
+// # Leaked Goroutines
+//
+// Start also fails the test if any goroutine started after it is still running once
+// the application has stopped. Idle connections kept alive by HTTP clients count as
+// leaked goroutines, so tests should close them with CloseIdleConnections or ignore
+// them with IgnoreGoroutines. Since the goroutines of parallel tests cannot be told
+// apart, tests using t.Parallel should disable the check with SkipLeakCheck.
+package bedrocktest
@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrocktest
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// standardGoroutines lists functions of goroutines which are started by the
+// standard library on first use and never exit, so are not leaks.
+var standardGoroutines = []string{
+	"os/signal.loop",
+	"os/signal.signal_recv",
+	"runtime.ensureSigM",
+}
+
+// goroutine is the stack of a single goroutine.
+type goroutine struct {
+	id    uint64
+	stack string
+}
+
+// goroutines returns the stacks of every goroutine other than the calling one.
+func goroutines() []goroutine {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	// The first stack is always the calling goroutine.
+	stacks := bytes.Split(buf, []byte("\n\n"))
+	gs := make([]goroutine, 0, len(stacks))
+	for _, stack := range stacks[1:] {
+		header, _, _ := strings.Cut(string(stack), "\n")
+		idStr, _, _ := strings.Cut(strings.TrimPrefix(header, "goroutine "), " ")
+		id, err := strconv.ParseUint(idStr, 10, 64)
+		if err != nil {
+			continue
+		}
+		gs = append(gs, goroutine{id: id, stack: string(stack)})
+	}
+	return gs
+}
+
+// goroutineIDs returns the IDs of every goroutine other than the calling one.
+func goroutineIDs() map[uint64]struct{} {
+	ids := make(map[uint64]struct{})
+	for _, g := range goroutines() {
+		ids[g.id] = struct{}{}
+	}
+	return ids
+}
+
+// leakedGoroutines waits up to timeout for every goroutine started since before
+// was taken to exit, and returns those which have not.
+func leakedGoroutines(before map[uint64]struct{}, timeout time.Duration, ignore []string) []goroutine {
+	deadline := time.Now().Add(timeout)
+	for {
+		var leaked []goroutine
+		for _, g := range goroutines() {
+			if _, ok := before[g.id]; ok {
+				continue
+			}
+			if isIgnored(g, ignore) {
+				continue
+			}
+			leaked = append(leaked, g)
+		}
+
+		if len(leaked) == 0 || time.Now().After(deadline) {
+			return leaked
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// isIgnored reports whether the stack of g contains a standard goroutine or one of ignore.
+func isIgnored(g goroutine, ignore []string) bool {
+	for _, fn := range standardGoroutines {
+		if strings.Contains(g.stack, fn) {
+			return true
+		}
+	}
+	for _, fn := range ignore {
+		if strings.Contains(g.stack, fn) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinStacks(gs []goroutine) string {
+	stacks := make([]string, len(gs))
+	for i, g := range gs {
+		stacks[i] = g.stack
+	}
+	return strings.Join(stacks, "\n\n")
+}
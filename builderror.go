@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/z5labs/bedrock/config"
+)
+
+// BuildError records which application component failed to build and,
+// if the failure was caused by reading configuration, which setting.
+type BuildError struct {
+	// Path lists the names of the components being built when the error
+	// occurred, from the outermost component to the innermost.
+	Path []string
+
+	// Key is the name of the configuration setting which could not be read.
+	// It is empty if the error was not caused by reading configuration.
+	Key string
+
+	// Source is where the setting was read from, e.g. the environment variable
+	// or file setting an operator has to set or correct. Its Kind is
+	// config.SourceUnknown if the error was not caused by reading configuration
+	// or the source of the setting is not known, e.g. because it is read from
+	// one of several sources.
+	Source config.Source
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the [error] interface.
+func (e *BuildError) Error() string {
+	var sb strings.Builder
+	sb.WriteString("build")
+	if len(e.Path) > 0 {
+		sb.WriteString(" ")
+		sb.WriteString(strings.Join(e.Path, " > "))
+	}
+	sb.WriteString(": ")
+	if e.Key != "" {
+		sb.WriteString("config ")
+		sb.WriteString(strconv.Quote(e.Key))
+		if e.Source.Kind != config.SourceUnknown {
+			sb.WriteString(" (")
+			sb.WriteString(e.Source.String())
+			sb.WriteString(")")
+		}
+		sb.WriteString(": ")
+	}
+	if e.Err != nil {
+		sb.WriteString(e.Err.Error())
+	}
+	return sb.String()
+}
+
+// Unwrap returns the underlying error.
+func (e *BuildError) Unwrap() error {
+	return e.Err
+}
+
+// WrapBuildError records component as the outermost component in the path of err.
+// If err is already a *BuildError, a copy with component prepended to its Path is
+// returned. If err joins multiple errors, such as those returned by [All], each of
+// them is wrapped individually. Otherwise, err is wrapped in a new *BuildError.
+// WrapBuildError returns nil if err is nil.
+func WrapBuildError(component string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs := joined.Unwrap()
+		wrapped := make([]error, 0, len(errs))
+		for _, e := range errs {
+			wrapped = append(wrapped, WrapBuildError(component, e))
+		}
+		return errors.Join(wrapped...)
+	}
+
+	be, ok := err.(*BuildError)
+	if !ok {
+		return &BuildError{
+			Path: []string{component},
+			Err:  err,
+		}
+	}
+
+	path := make([]string, 0, len(be.Path)+1)
+	path = append(path, component)
+	path = append(path, be.Path...)
+	return &BuildError{
+		Path:   path,
+		Key:    be.Key,
+		Source: be.Source,
+		Err:    be.Err,
+	}
+}
+
+// ConfigError wraps err, which was returned while reading the configuration
+// setting named key, in a *BuildError. If err is or wraps a *config.SourceError,
+// its Source is recorded as well. It returns nil if err is nil.
+//
+// The returned error does not record any component yet, so it is typically
+// passed to [WrapBuildError] by the component reading the setting.
+func ConfigError(key string, err error) error {
+	if err == nil {
+		return nil
+	}
+	be := &BuildError{
+		Key: key,
+		Err: err,
+	}
+	var srcErr *config.SourceError
+	if errors.As(err, &srcErr) {
+		be.Source = srcErr.Source
+	}
+	return be
+}
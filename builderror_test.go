@@ -0,0 +1,144 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/z5labs/bedrock/config"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildError_Error(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      *BuildError
+		expected string
+	}{
+		{
+			name:     "without path or key",
+			err:      &BuildError{Err: errors.New("failed")},
+			expected: "build: failed",
+		},
+		{
+			name:     "with path",
+			err:      &BuildError{Path: []string{"otel.Runtime", "TracerProvider"}, Err: errors.New("failed")},
+			expected: "build otel.Runtime > TracerProvider: failed",
+		},
+		{
+			name:     "with path and key",
+			err:      &BuildError{Path: []string{"TCPListener"}, Key: "addr", Err: errors.New("value not set")},
+			expected: `build TCPListener: config "addr": value not set`,
+		},
+		{
+			name: "with path, key and source",
+			err: &BuildError{
+				Path:   []string{"TCPListener"},
+				Key:    "addr",
+				Source: config.Source{Kind: config.SourceEnv, Name: "HTTP_ADDR"},
+				Err:    errors.New("value not set"),
+			},
+			expected: `build TCPListener: config "addr" (env HTTP_ADDR): value not set`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.err.Error())
+		})
+	}
+}
+
+func TestWrapBuildError(t *testing.T) {
+	t.Run("returns nil for nil error", func(t *testing.T) {
+		require.NoError(t, WrapBuildError("component", nil))
+	})
+
+	t.Run("wraps plain errors", func(t *testing.T) {
+		cause := errors.New("dial failed")
+
+		err := WrapBuildError("OtlpGrpcSpanExporter", cause)
+		require.ErrorIs(t, err, cause)
+
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"OtlpGrpcSpanExporter"}, buildErr.Path)
+		require.Empty(t, buildErr.Key)
+	})
+
+	t.Run("prepends components to an existing build error", func(t *testing.T) {
+		cause := errors.New("value not set")
+
+		err := ConfigError("endpoint", cause)
+		err = WrapBuildError("OtlpHttpSpanExporter", err)
+		err = WrapBuildError("BatchSpanProcessor", err)
+		err = WrapBuildError("TracerProvider", err)
+		require.ErrorIs(t, err, cause)
+
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"TracerProvider", "BatchSpanProcessor", "OtlpHttpSpanExporter"}, buildErr.Path)
+		require.Equal(t, "endpoint", buildErr.Key)
+	})
+
+	t.Run("does not modify the wrapped build error", func(t *testing.T) {
+		inner := &BuildError{Path: []string{"inner"}, Err: errors.New("failed")}
+
+		_ = WrapBuildError("outer", inner)
+		require.Equal(t, []string{"inner"}, inner.Path)
+	})
+
+	t.Run("keeps build errors wrapped by other errors intact", func(t *testing.T) {
+		inner := WrapBuildError("inner", errors.New("failed"))
+		wrapped := fmt.Errorf("custom builder: %w", inner)
+
+		err := WrapBuildError("outer", wrapped)
+		require.Equal(t, "build outer: custom builder: build inner: failed", err.Error())
+	})
+
+	t.Run("wraps each joined error", func(t *testing.T) {
+		err1 := ConfigError("addr", errors.New("value not set"))
+		err2 := errors.New("failed")
+
+		err := WrapBuildError("outer", errors.Join(err1, err2))
+		require.ErrorIs(t, err, err2)
+		require.Equal(t, "build outer: config \"addr\": value not set\nbuild outer: failed", err.Error())
+	})
+}
+
+func TestConfigError(t *testing.T) {
+	t.Run("returns nil for nil error", func(t *testing.T) {
+		require.NoError(t, ConfigError("key", nil))
+	})
+
+	t.Run("records the key", func(t *testing.T) {
+		cause := errors.New("invalid duration")
+
+		err := ConfigError("ReadTimeout", cause)
+		require.ErrorIs(t, err, cause)
+
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, "ReadTimeout", buildErr.Key)
+		require.Empty(t, buildErr.Path)
+	})
+
+	t.Run("records the source of the setting", func(t *testing.T) {
+		src := config.Source{Kind: config.SourceEnv, Name: "HTTP_READ_TIMEOUT"}
+		cause := &config.SourceError{Source: src, Err: errors.New("invalid duration")}
+
+		err := ConfigError("ReadTimeout", cause)
+		require.ErrorIs(t, err, cause)
+
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, src, buildErr.Source)
+		require.Equal(t, `build: config "ReadTimeout" (env HTTP_READ_TIMEOUT): invalid duration`, err.Error())
+	})
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// cleanupStack collects release functions registered while building application
+// components so they can be run in reverse construction order.
+type cleanupStack struct {
+	mu  sync.Mutex
+	fns []func(context.Context) error
+}
+
+func (s *cleanupStack) push(fn func(context.Context) error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.fns = append(s.fns, fn)
+}
+
+func (s *cleanupStack) unwind(ctx context.Context) error {
+	s.mu.Lock()
+	fns := s.fns
+	s.fns = nil
+	s.mu.Unlock()
+
+	errs := make([]error, 0, len(fns))
+	for i := len(fns) - 1; i >= 0; i-- {
+		errs = append(errs, fns[i](ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// adopt moves the release functions collected by s onto the cleanup scope of ctx,
+// if any, keeping their order, so they are released along with its other components.
+func (s *cleanupStack) adopt(ctx context.Context) {
+	s.mu.Lock()
+	fns := s.fns
+	s.fns = nil
+	s.mu.Unlock()
+
+	for _, fn := range fns {
+		OnCleanup(ctx, fn)
+	}
+}
+
+type cleanupStackKey struct{}
+
+// WithCleanup returns a copy of ctx which collects release functions registered
+// with [OnCleanup], along with a function that runs them in reverse registration order.
+//
+// The returned function runs every registered release function even if some of them
+// fail and returns their errors joined together. Once called, the collected release
+// functions are discarded so calling it again is a no-op.
+//
+// [DefaultRunner] already manages a cleanup scope, so WithCleanup is only needed when
+// implementing custom Runners or building components outside of a Runner.
+func WithCleanup(ctx context.Context) (context.Context, func(context.Context) error) {
+	stack := &cleanupStack{}
+	return context.WithValue(ctx, cleanupStackKey{}, stack), stack.unwind
+}
+
+// OnCleanup registers fn to be called when the components built with ctx are released.
+// It reports whether fn was registered, which is only the case if ctx was derived from
+// a context returned by [WithCleanup].
+//
+// OnCleanup is safe for concurrent use.
+func OnCleanup(ctx context.Context, fn func(context.Context) error) bool {
+	stack, ok := ctx.Value(cleanupStackKey{}).(*cleanupStack)
+	if !ok {
+		return false
+	}
+	stack.push(fn)
+	return true
+}
+
+// BuildWithCleanup wraps a Builder so that release is registered with [OnCleanup]
+// once the value has been built successfully.
+func BuildWithCleanup[T any](builder Builder[T], release func(context.Context, T) error) Builder[T] {
+	return BuilderFunc[T](func(ctx context.Context) (T, error) {
+		value, err := builder.Build(ctx)
+		if err != nil {
+			return value, err
+		}
+
+		OnCleanup(ctx, func(ctx context.Context) error {
+			return release(ctx, value)
+		})
+		return value, nil
+	})
+}
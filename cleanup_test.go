@@ -0,0 +1,232 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnCleanup(t *testing.T) {
+	t.Run("reports false without a cleanup scope", func(t *testing.T) {
+		registered := OnCleanup(context.Background(), func(ctx context.Context) error {
+			return nil
+		})
+		require.False(t, registered)
+	})
+
+	t.Run("runs release functions in reverse registration order", func(t *testing.T) {
+		ctx, release := WithCleanup(context.Background())
+
+		var order []int
+		for i := range 3 {
+			registered := OnCleanup(ctx, func(ctx context.Context) error {
+				order = append(order, i)
+				return nil
+			})
+			require.True(t, registered)
+		}
+
+		require.NoError(t, release(context.Background()))
+		require.Equal(t, []int{2, 1, 0}, order)
+	})
+
+	t.Run("runs all release functions and joins their errors", func(t *testing.T) {
+		ctx, release := WithCleanup(context.Background())
+
+		err1 := errors.New("release 1 failed")
+		err2 := errors.New("release 2 failed")
+
+		called := 0
+		OnCleanup(ctx, func(ctx context.Context) error {
+			called++
+			return err1
+		})
+		OnCleanup(ctx, func(ctx context.Context) error {
+			called++
+			return nil
+		})
+		OnCleanup(ctx, func(ctx context.Context) error {
+			called++
+			return err2
+		})
+
+		err := release(context.Background())
+		require.ErrorIs(t, err, err1)
+		require.ErrorIs(t, err, err2)
+		require.Equal(t, 3, called)
+	})
+
+	t.Run("only releases once", func(t *testing.T) {
+		ctx, release := WithCleanup(context.Background())
+
+		called := 0
+		OnCleanup(ctx, func(ctx context.Context) error {
+			called++
+			return nil
+		})
+
+		require.NoError(t, release(context.Background()))
+		require.NoError(t, release(context.Background()))
+		require.Equal(t, 1, called)
+	})
+}
+
+func TestBuildWithCleanup(t *testing.T) {
+	t.Run("registers release with the built value", func(t *testing.T) {
+		ctx, release := WithCleanup(context.Background())
+
+		var released int
+		b := BuildWithCleanup(BuilderOf(42), func(ctx context.Context, v int) error {
+			released = v
+			return nil
+		})
+
+		v, err := b.Build(ctx)
+		require.NoError(t, err)
+		require.Equal(t, 42, v)
+		require.Zero(t, released)
+
+		require.NoError(t, release(context.Background()))
+		require.Equal(t, 42, released)
+	})
+
+	t.Run("does not register release when build fails", func(t *testing.T) {
+		ctx, release := WithCleanup(context.Background())
+
+		buildErr := errors.New("build failed")
+		called := false
+		b := BuildWithCleanup(
+			BuilderFunc[int](func(ctx context.Context) (int, error) {
+				return 0, buildErr
+			}),
+			func(ctx context.Context, v int) error {
+				called = true
+				return nil
+			},
+		)
+
+		_, err := b.Build(ctx)
+		require.ErrorIs(t, err, buildErr)
+
+		require.NoError(t, release(context.Background()))
+		require.False(t, called)
+	})
+}
+
+func TestDefaultRunner_Cleanup(t *testing.T) {
+	t.Run("releases resources in reverse order after the runtime returns", func(t *testing.T) {
+		var events []string
+		resource := func(name string) Builder[string] {
+			return BuildWithCleanup(BuilderOf(name), func(ctx context.Context, s string) error {
+				events = append(events, "release "+s)
+				return nil
+			})
+		}
+
+		builder := Bind(resource("db"), func(ctx context.Context, db string) Builder[Runtime] {
+			return Map(resource("listener"), func(ctx context.Context, ln string) (Runtime, error) {
+				return RuntimeFunc(func(ctx context.Context) error {
+					events = append(events, "run")
+					return nil
+				}), nil
+			})
+		})
+
+		err := DefaultRunner[Runtime]().Run(context.Background(), builder)
+		require.NoError(t, err)
+		require.Equal(t, []string{"run", "release listener", "release db"}, events)
+	})
+
+	t.Run("releases already built resources when a later builder fails", func(t *testing.T) {
+		released := false
+		buildErr := errors.New("handler failed")
+
+		builder := Bind(
+			BuildWithCleanup(BuilderOf("listener"), func(ctx context.Context, s string) error {
+				released = true
+				return nil
+			}),
+			func(ctx context.Context, ln string) Builder[Runtime] {
+				return BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+					return nil, buildErr
+				})
+			},
+		)
+
+		err := DefaultRunner[Runtime]().Run(context.Background(), builder)
+		require.ErrorIs(t, err, buildErr)
+		require.True(t, released)
+	})
+
+	t.Run("releases resources when a builder panics", func(t *testing.T) {
+		released := false
+
+		builder := Bind(
+			BuildWithCleanup(BuilderOf("listener"), func(ctx context.Context, s string) error {
+				released = true
+				return nil
+			}),
+			func(ctx context.Context, ln string) Builder[Runtime] {
+				return BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+					return MustBuild(ctx, BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+						return nil, errors.New("missing config")
+					})), nil
+				})
+			},
+		)
+
+		err := RecoverPanics(DefaultRunner[Runtime]()).Run(context.Background(), builder)
+		require.Error(t, err)
+		require.True(t, released)
+	})
+
+	t.Run("joins release errors with the runtime error", func(t *testing.T) {
+		runErr := errors.New("run failed")
+		releaseErr := errors.New("release failed")
+
+		builder := Map(
+			BuildWithCleanup(BuilderOf("conn"), func(ctx context.Context, s string) error {
+				return releaseErr
+			}),
+			func(ctx context.Context, conn string) (Runtime, error) {
+				return RuntimeFunc(func(ctx context.Context) error {
+					return runErr
+				}), nil
+			},
+		)
+
+		err := DefaultRunner[Runtime]().Run(context.Background(), builder)
+		require.ErrorIs(t, err, runErr)
+		require.ErrorIs(t, err, releaseErr)
+	})
+
+	t.Run("release context is not cancelled with the run context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var releaseCtxErr error
+		builder := Map(
+			BuildWithCleanup(BuilderOf("conn"), func(ctx context.Context, s string) error {
+				releaseCtxErr = ctx.Err()
+				return nil
+			}),
+			func(ctx context.Context, conn string) (Runtime, error) {
+				return RuntimeFunc(func(ctx context.Context) error {
+					cancel()
+					<-ctx.Done()
+					return nil
+				}), nil
+			},
+		)
+
+		err := DefaultRunner[Runtime]().Run(ctx, builder)
+		require.NoError(t, err)
+		require.NoError(t, releaseCtxErr)
+	})
+}
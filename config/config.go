@@ -17,8 +17,10 @@ import (
 
 // Value represents a configuration value that may or may not be set.
 type Value[T any] struct {
-	val T
-	set bool
+	val    T
+	set    bool
+	src    Source
+	secret bool
 }
 
 // ValueOf creates a Value that is set to the given value.
@@ -31,6 +33,22 @@ func (v Value[T]) Value() (T, bool) {
 	return v.val, v.set
 }
 
+// Source returns where the value was read from.
+func (v Value[T]) Source() Source {
+	return v.src
+}
+
+// WithSource returns a copy of v which was read from src.
+func (v Value[T]) WithSource(src Source) Value[T] {
+	v.src = src
+	return v
+}
+
+// IsSecret reports whether the value was read by a Reader returned by [Secret].
+func (v Value[T]) IsSecret() bool {
+	return v.secret
+}
+
 // Reader is an interface for reading configuration values.
 type Reader[T any] interface {
 	Read(context.Context) (Value[T], error)
@@ -100,11 +118,11 @@ func Default[T any](defaultVal T, reader Reader[T]) Reader[T] {
 			return Value[T]{}, err
 		}
 
-		if v, ok := val.Value(); ok {
-			return ValueOf(v), nil
+		if val.set {
+			return val, nil
 		}
 
-		return ValueOf(defaultVal), nil
+		return ValueOf(defaultVal).WithSource(Source{Kind: SourceDefault}), nil
 	})
 }
 
@@ -117,8 +135,8 @@ func Or[T any](readers ...Reader[T]) Reader[T] {
 				return Value[T]{}, err
 			}
 
-			if v, ok := val.Value(); ok {
-				return ValueOf(v), nil
+			if val.set {
+				return val, nil
 			}
 		}
 
@@ -127,6 +145,8 @@ func Or[T any](readers ...Reader[T]) Reader[T] {
 }
 
 // Map transforms the output of a Reader using the provided mapping function.
+// The mapped value keeps the source of the original value, and errors returned
+// by mapper are wrapped in a *SourceError naming it.
 func Map[A, B any](reader Reader[A], mapper func(context.Context, A) (B, error)) Reader[B] {
 	return ReaderFunc[B](func(ctx context.Context) (Value[B], error) {
 		aVal, err := reader.Read(ctx)
@@ -136,15 +156,15 @@ func Map[A, B any](reader Reader[A], mapper func(context.Context, A) (B, error))
 
 		a, ok := aVal.Value()
 		if !ok {
-			return Value[B]{}, nil
+			return Value[B]{src: aVal.src}, nil
 		}
 
 		b, err := mapper(ctx, a)
 		if err != nil {
-			return Value[B]{}, err
+			return Value[B]{}, withSource(aVal.src, err)
 		}
 
-		return ValueOf(b), nil
+		return Value[B]{val: b, set: true, src: aVal.src, secret: aVal.secret}, nil
 	})
 }
 
@@ -158,7 +178,7 @@ func Bind[A, B any](reader Reader[A], binder func(context.Context, A) Reader[B])
 
 		a, ok := aVal.Value()
 		if !ok {
-			return Value[B]{}, nil
+			return Value[B]{src: aVal.src}, nil
 		}
 
 		return binder(ctx, a).Read(ctx)
@@ -168,12 +188,13 @@ func Bind[A, B any](reader Reader[A], binder func(context.Context, A) Reader[B])
 // Env returns a Reader that reads a string value from the environment variable with the given name.
 func Env(name string) Reader[string] {
 	return ReaderFunc[string](func(ctx context.Context) (Value[string], error) {
+		src := Source{Kind: SourceEnv, Name: name}
 		val, ok := os.LookupEnv(name)
 		if !ok {
-			return Value[string]{}, nil
+			return Value[string]{src: src}, nil
 		}
 
-		return ValueOf(val), nil
+		return ValueOf(val).WithSource(src), nil
 	})
 }
 
@@ -188,7 +209,7 @@ func ReadFile(path string) Reader[*os.File] {
 			return Value[*os.File]{}, err
 		}
 
-		return ValueOf(f), nil
+		return ValueOf(f).WithSource(Source{Kind: SourceFile, File: path}), nil
 	})
 }
 
@@ -57,6 +57,75 @@
 //	    },
 //	)
 //
+// # Struct Binding
+//
+// Struct reads many settings at once into a struct whose fields are tagged with
+// the environment variables they are read from:
+//
+//	type Config struct {
+//	    Port    int           `env:"PORT" default:"8080"`
+//	    Timeout time.Duration `env:"TIMEOUT" default:"30s"`
+//	    Token   string        `env:"TOKEN" required:"true"`
+//	    DB      DBConfig      `prefix:"DB_"`
+//	}
+//
+//	cfg, err := config.Read(ctx, config.Struct[Config]())
+//
+// Every missing or malformed field is reported in a single error, rather than
+// one at a time.
+//
+// # Configuration Files
+//
+// JSONFile, YAMLFile and TOMLFile parse a configuration file once, on first use,
+// and Path reads individual settings from it by their dot separated path. Missing
+// settings are not set, so files can be layered with other sources using Or:
+//
+//	file := config.YAMLFile("config.yaml")
+//
+//	readTimeout := config.Or(
+//	    config.DurationFromString(config.Env("HTTP_READ_TIMEOUT")),
+//	    config.Path[time.Duration](file, "server.http.read_timeout"),
+//	)
+//
+// # Dotenv Files
+//
+// DotEnvFile reads variables from a dotenv file, such as the .env files commonly
+// used during local development, without changing the environment of the process.
+// Layering it behind Env lets real environment variables take precedence:
+//
+//	dotenv := config.DotEnvFile(".env")
+//	port := config.Or(config.Env("PORT"), dotenv.Env("PORT"))
+//
+// # Command Line Flags
+//
+// Flag defines a flag whose Reader is only set when the flag is given explicitly,
+// so flags, environment variables, files and defaults can be layered in order of
+// precedence:
+//
+//	flags := config.NewFlags(flag.CommandLine)
+//	port := config.Default(8080, config.Or(
+//	    config.Flag[int](flags, "port", "port to listen on", config.FlagEnv("PORT"), config.FlagDefault(8080)),
+//	    config.IntFromString(config.Env("PORT")),
+//	    config.Path[int](file, "server.port"),
+//	))
+//
+//	err := flags.Parse(os.Args[1:])
+//
+// The usage message printed for --help lists the environment variable and
+// default of each flag.
+//
+// # Provenance
+//
+// Every Value records its Source, e.g. the environment variable or the file and
+// line it was read from, which is kept as it passes through Or, Default and Map.
+// WithReport collects the values read while starting an application, so the
+// source of each one can be logged, with values wrapped by Secret redacted:
+//
+//	ctx, report := config.WithReport(ctx)
+//	token := config.Secret(config.Env("API_TOKEN"))
+//	...
+//	logger.Info("configuration loaded", "config", report)
+//
 // # Error Handling
 //
 // Readers distinguish between three states:
@@ -0,0 +1,361 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// DotEnv is a dotenv file, as commonly used to set environment variables during
+// local development, whose variables are read by [DotEnv.Env].
+//
+// The file is read and parsed the first time any of its variables are read, and
+// the parsed variables are reused by subsequent reads until the size or
+// modification time of the file changes. Errors reading or parsing the file are
+// not reused. Reading a DotEnv never changes the environment of the process.
+type DotEnv struct {
+	path  string
+	parse func() (map[string]dotEnvVar, error)
+}
+
+type dotEnvVar struct {
+	value string
+	line  int
+}
+
+// DotEnvFile returns a DotEnv which parses the dotenv file at path, e.g. ".env".
+// If the file does not exist, none of its variables are set.
+//
+// Each line of the file sets a variable, NAME=value, optionally prefixed with
+// export. Blank lines and lines starting with # are ignored. Values may be:
+//   - unquoted, in which case surrounding whitespace and comments starting with
+//     whitespace followed by # are removed
+//   - single quoted, in which case they are used as is
+//   - double quoted, in which case the escapes \n, \r, \t, \", \\ and \$ are replaced
+//
+// Quoted values may span multiple lines. Unquoted and double quoted values expand
+// references to other variables, written as $NAME, ${NAME} or ${NAME:-default}, where
+// the default is used if NAME is unset or empty. References are resolved from the
+// environment of the process first, then from the variables set earlier in the file,
+// so they match the values a Reader such as Or(Env(name), dotenv.Env(name)) returns.
+func DotEnvFile(path string) *DotEnv {
+	cache := &fileCache[map[string]dotEnvVar]{
+		path: path,
+		parse: func(data []byte) (map[string]dotEnvVar, error) {
+			vars, err := parseDotEnv(string(data))
+			if err != nil {
+				return nil, fmt.Errorf("config: parsing %s: %w", path, err)
+			}
+			return vars, nil
+		},
+	}
+	return &DotEnv{
+		path:  path,
+		parse: cache.load,
+	}
+}
+
+// Env returns a Reader which reads the variable named name from the file. It can
+// be layered with [Env] to let the environment of the process override the file:
+//
+//	port := config.Or(config.Env("PORT"), dotenv.Env("PORT"))
+//
+// Env can also be used with [Struct]:
+//
+//	cfg := config.Struct[Config](config.Lookup(func(key string) config.Reader[string] {
+//	    return config.Or(config.Env(key), dotenv.Env(key))
+//	}))
+func (d *DotEnv) Env(name string) Reader[string] {
+	return ReaderFunc[string](func(ctx context.Context) (Value[string], error) {
+		vars, err := d.parse()
+		if err != nil {
+			return Value[string]{}, err
+		}
+
+		v, ok := vars[name]
+		src := Source{
+			Kind: SourceFile,
+			Name: name,
+			File: d.path,
+			Line: v.line,
+		}
+		if !ok {
+			return Value[string]{src: src}, nil
+		}
+		return ValueOf(v.value).WithSource(src), nil
+	})
+}
+
+// dotEnvParser parses the variables of a dotenv file.
+type dotEnvParser struct {
+	src  string
+	pos  int
+	line int
+	vars map[string]dotEnvVar
+}
+
+// parseDotEnv parses src, returning errors prefixed with the line they occurred on.
+func parseDotEnv(src string) (map[string]dotEnvVar, error) {
+	p := &dotEnvParser{
+		src:  src,
+		line: 1,
+		vars: make(map[string]dotEnvVar),
+	}
+
+	for {
+		p.skipSpace(true)
+		if p.eof() {
+			return p.vars, nil
+		}
+		if p.peek() == '#' {
+			p.skipLine()
+			continue
+		}
+
+		line := p.line
+		err := p.parseVar()
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+}
+
+func (p *dotEnvParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *dotEnvParser) peek() byte {
+	return p.src[p.pos]
+}
+
+// next consumes and returns the next byte, counting lines.
+func (p *dotEnvParser) next() byte {
+	c := p.src[p.pos]
+	p.pos++
+	if c == '\n' {
+		p.line++
+	}
+	return c
+}
+
+// skipSpace skips whitespace, including newlines only if newlines is true.
+func (p *dotEnvParser) skipSpace(newlines bool) {
+	for !p.eof() {
+		switch p.peek() {
+		case ' ', '\t', '\r':
+		case '\n':
+			if !newlines {
+				return
+			}
+		default:
+			return
+		}
+		p.next()
+	}
+}
+
+// skipLine skips the rest of the current line, including the newline.
+func (p *dotEnvParser) skipLine() {
+	for !p.eof() && p.next() != '\n' {
+	}
+}
+
+// endLine consumes the rest of the line after a quoted value, which may only
+// contain whitespace and a comment.
+func (p *dotEnvParser) endLine() error {
+	p.skipSpace(false)
+	if p.eof() || p.peek() == '\n' {
+		return nil
+	}
+	if p.peek() != '#' {
+		return fmt.Errorf("unexpected %q after quoted value", p.peek())
+	}
+	p.skipLine()
+	return nil
+}
+
+func (p *dotEnvParser) parseVar() error {
+	name := p.parseName()
+	if name == "export" {
+		p.skipSpace(false)
+		if !p.eof() && p.peek() != '=' {
+			name = p.parseName()
+		}
+	}
+	if name == "" {
+		return fmt.Errorf("expected variable name, found %q", p.peek())
+	}
+
+	line := p.line
+	p.skipSpace(false)
+	if p.eof() || p.peek() != '=' {
+		return fmt.Errorf("expected = after %s", name)
+	}
+	p.next()
+	p.skipSpace(false)
+
+	var (
+		value string
+		err   error
+	)
+	switch {
+	case p.eof():
+	case p.peek() == '\'':
+		value, err = p.parseSingleQuoted()
+	case p.peek() == '"':
+		value, err = p.parseDoubleQuoted()
+	default:
+		value, err = p.parseUnquoted()
+	}
+	if err != nil {
+		return err
+	}
+
+	p.vars[name] = dotEnvVar{value: value, line: line}
+	return nil
+}
+
+func isNameByte(c byte, first bool) bool {
+	switch {
+	case c == '_', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		return true
+	case '0' <= c && c <= '9':
+		return !first
+	default:
+		return false
+	}
+}
+
+func (p *dotEnvParser) parseName() string {
+	start := p.pos
+	for !p.eof() && isNameByte(p.peek(), p.pos == start) {
+		p.next()
+	}
+	return p.src[start:p.pos]
+}
+
+func (p *dotEnvParser) parseSingleQuoted() (string, error) {
+	p.next()
+	start := p.pos
+	for !p.eof() {
+		if p.peek() == '\'' {
+			value := p.src[start:p.pos]
+			p.next()
+			return value, p.endLine()
+		}
+		p.next()
+	}
+	return "", errors.New("unterminated single quoted value")
+}
+
+func (p *dotEnvParser) parseDoubleQuoted() (string, error) {
+	p.next()
+
+	var sb strings.Builder
+	for !p.eof() {
+		c := p.next()
+		switch c {
+		case '"':
+			return sb.String(), p.endLine()
+		case '\\':
+			if p.eof() {
+				break
+			}
+			switch e := p.next(); e {
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case '"', '\\', '$':
+				sb.WriteByte(e)
+			default:
+				sb.WriteByte('\\')
+				sb.WriteByte(e)
+			}
+		case '$':
+			err := p.expand(&sb)
+			if err != nil {
+				return "", err
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return "", errors.New("unterminated double quoted value")
+}
+
+func (p *dotEnvParser) parseUnquoted() (string, error) {
+	var sb strings.Builder
+	for !p.eof() && p.peek() != '\n' {
+		c := p.next()
+		switch {
+		case c == '#' && isSpace(p.src[p.pos-2]):
+			p.skipLine()
+			return strings.TrimSpace(sb.String()), nil
+		case c == '$':
+			err := p.expand(&sb)
+			if err != nil {
+				return "", err
+			}
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return strings.TrimSpace(sb.String()), nil
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t'
+}
+
+// expand writes the value of the variable referenced after a $ to sb.
+func (p *dotEnvParser) expand(sb *strings.Builder) error {
+	if !p.eof() && p.peek() == '{' {
+		p.next()
+		start := p.pos
+		end := strings.IndexByte(p.src[start:], '}')
+		if end < 0 {
+			return errors.New("unterminated variable reference")
+		}
+		ref := p.src[start : start+end]
+		for range end + 1 {
+			p.next()
+		}
+
+		name, def, hasDefault := strings.Cut(ref, ":-")
+		value, _ := p.lookup(name)
+		if value == "" && hasDefault {
+			value = def
+		}
+		sb.WriteString(value)
+		return nil
+	}
+
+	name := p.parseName()
+	if name == "" {
+		sb.WriteByte('$')
+		return nil
+	}
+	value, _ := p.lookup(name)
+	sb.WriteString(value)
+	return nil
+}
+
+// lookup resolves a variable reference from the environment, then from the
+// variables set earlier in the file.
+func (p *dotEnvParser) lookup(name string) (string, bool) {
+	if v, ok := os.LookupEnv(name); ok {
+		return v, true
+	}
+	v, ok := p.vars[name]
+	return v.value, ok
+}
@@ -0,0 +1,253 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDotEnv(t *testing.T) {
+	t.Setenv("TEST_DOTENV_HOST", "example.com")
+
+	testCases := []struct {
+		name     string
+		src      string
+		expected map[string]string
+	}{
+		{
+			name:     "empty",
+			src:      "",
+			expected: map[string]string{},
+		},
+		{
+			name: "unquoted",
+			src:  "A=1\nB = two words  \nC=\nexport D=4\n",
+			expected: map[string]string{
+				"A": "1",
+				"B": "two words",
+				"C": "",
+				"D": "4",
+			},
+		},
+		{
+			name: "comments",
+			src:  "# comment\n  # indented comment\nA=1 # trailing comment\nB=a#b\n\nC='x' # comment\n",
+			expected: map[string]string{
+				"A": "1",
+				"B": "a#b",
+				"C": "x",
+			},
+		},
+		{
+			name: "single quoted",
+			src:  `A='$B \n # "x"'`,
+			expected: map[string]string{
+				"A": `$B \n # "x"`,
+			},
+		},
+		{
+			name: "double quoted",
+			src:  `A="a\tb\nc \"q\" \\ \$B # not a comment"`,
+			expected: map[string]string{
+				"A": "a\tb\nc \"q\" \\ $B # not a comment",
+			},
+		},
+		{
+			name: "multiline",
+			src:  "KEY=\"-----BEGIN-----\nabc\n-----END-----\"\nSINGLE='a\nb'\nNEXT=1",
+			expected: map[string]string{
+				"KEY":    "-----BEGIN-----\nabc\n-----END-----",
+				"SINGLE": "a\nb",
+				"NEXT":   "1",
+			},
+		},
+		{
+			name: "expansion",
+			src: `PORT=8080
+ADDR=$TEST_DOTENV_HOST:$PORT
+URL="http://${TEST_DOTENV_HOST}:${PORT}/"
+MISSING=[$TEST_DOTENV_UNSET]
+DEFAULT=${TEST_DOTENV_UNSET:-fallback}
+PRICE=$5 $
+`,
+			expected: map[string]string{
+				"PORT":    "8080",
+				"ADDR":    "example.com:8080",
+				"URL":     "http://example.com:8080/",
+				"MISSING": "[]",
+				"DEFAULT": "fallback",
+				"PRICE":   "$5 $",
+			},
+		},
+		{
+			name: "environment takes precedence in expansion",
+			src:  "TEST_DOTENV_HOST=localhost\nURL=http://$TEST_DOTENV_HOST",
+			expected: map[string]string{
+				"TEST_DOTENV_HOST": "localhost",
+				"URL":              "http://example.com",
+			},
+		},
+		{
+			name: "later definitions override earlier ones",
+			src:  "A=1\nA=2",
+			expected: map[string]string{
+				"A": "2",
+			},
+		},
+		{
+			name: "windows line endings",
+			src:  "A=1\r\nB=\"2\"\r\n",
+			expected: map[string]string{
+				"A": "1",
+				"B": "2",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			vars, err := parseDotEnv(tc.src)
+			require.NoError(t, err)
+
+			values := make(map[string]string, len(vars))
+			for name, v := range vars {
+				values[name] = v.value
+			}
+			require.Equal(t, tc.expected, values)
+		})
+	}
+}
+
+func TestParseDotEnv_Errors(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      string
+		expected string
+	}{
+		{
+			name:     "missing name",
+			src:      "=1",
+			expected: `line 1: expected variable name, found '='`,
+		},
+		{
+			name:     "missing equals",
+			src:      "A=1\nB 2",
+			expected: "line 2: expected = after B",
+		},
+		{
+			name:     "unterminated single quote",
+			src:      "A='1",
+			expected: "line 1: unterminated single quoted value",
+		},
+		{
+			name:     "unterminated double quote",
+			src:      "A=1\nB=\"2\n3",
+			expected: "line 2: unterminated double quoted value",
+		},
+		{
+			name:     "unterminated reference",
+			src:      "A=${B",
+			expected: "line 1: unterminated variable reference",
+		},
+		{
+			name:     "text after quoted value",
+			src:      `A="1"2`,
+			expected: `line 1: unexpected '2' after quoted value`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseDotEnv(tc.src)
+			require.EqualError(t, err, tc.expected)
+		})
+	}
+}
+
+func TestDotEnv_Env(t *testing.T) {
+	path := writeFile(t, ".env", "# local settings\nPORT=8080\n\nHOST=localhost\n")
+	dotenv := DotEnvFile(path)
+
+	t.Run("reads variables", func(t *testing.T) {
+		val, err := dotenv.Env("HOST").Read(context.Background())
+		require.NoError(t, err)
+
+		host, ok := val.Value()
+		require.True(t, ok)
+		require.Equal(t, "localhost", host)
+		require.Equal(t, Source{Kind: SourceFile, Name: "HOST", File: path, Line: 4}, val.Source())
+	})
+
+	t.Run("missing variable is not set", func(t *testing.T) {
+		val, err := dotenv.Env("TIMEOUT").Read(context.Background())
+		require.NoError(t, err)
+
+		_, ok := val.Value()
+		require.False(t, ok)
+	})
+
+	t.Run("is overridden by the environment", func(t *testing.T) {
+		t.Setenv("PORT", "9090")
+		port := IntFromString(Or(Env("PORT"), dotenv.Env("PORT")))
+
+		v, err := Read(context.Background(), port)
+		require.NoError(t, err)
+		require.Equal(t, 9090, v)
+	})
+
+	t.Run("does not change the environment", func(t *testing.T) {
+		environ := os.Environ()
+
+		_, err := Read(context.Background(), dotenv.Env("HOST"))
+		require.NoError(t, err)
+		require.Equal(t, environ, os.Environ())
+	})
+
+	t.Run("with Struct", func(t *testing.T) {
+		type config struct {
+			Host string `env:"HOST"`
+			Port int    `env:"PORT"`
+		}
+
+		cfg, err := Read(context.Background(), Struct[config](Lookup(dotenv.Env)))
+		require.NoError(t, err)
+		require.Equal(t, config{Host: "localhost", Port: 8080}, cfg)
+	})
+
+	t.Run("missing file is not set", func(t *testing.T) {
+		val, err := DotEnvFile(filepath.Join(t.TempDir(), ".env")).Env("PORT").Read(context.Background())
+		require.NoError(t, err)
+
+		_, ok := val.Value()
+		require.False(t, ok)
+	})
+
+	t.Run("malformed file", func(t *testing.T) {
+		path := writeFile(t, ".env", "PORT='8080")
+
+		_, err := DotEnvFile(path).Env("PORT").Read(context.Background())
+		require.EqualError(t, err, "config: parsing "+path+": line 1: unterminated single quoted value")
+	})
+
+	t.Run("recovers from a malformed file", func(t *testing.T) {
+		path := writeFile(t, ".env", "PORT='8080")
+		dotenv := DotEnvFile(path)
+
+		_, err := dotenv.Env("PORT").Read(context.Background())
+		require.Error(t, err)
+
+		require.NoError(t, os.WriteFile(path, []byte("PORT=8080\n"), 0o600))
+
+		port, err := Read(context.Background(), dotenv.Env("PORT"))
+		require.NoError(t, err)
+		require.Equal(t, "8080", port)
+	})
+}
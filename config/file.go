@@ -0,0 +1,362 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"go.yaml.in/yaml/v3"
+)
+
+// UnmarshalFunc parses data into the value pointed to by v, e.g. [json.Unmarshal].
+type UnmarshalFunc func(data []byte, v any) error
+
+// Document is a structured configuration file, such as a JSON, YAML or TOML file,
+// whose settings are read by [Path].
+//
+// The file is read and parsed the first time any of its settings are read, and the
+// parsed document is reused by subsequent reads until the size or modification time
+// of the file changes, e.g. when it is reread by a bedrock.HotReload Runner. Errors
+// reading or parsing the file are not reused, so a later read can recover from them.
+type Document struct {
+	path  string
+	parse func() (*parsedDocument, error)
+}
+
+type parsedDocument struct {
+	tree map[string]any
+
+	// lines maps the paths of settings to the lines they are defined on,
+	// if the format supports finding them.
+	lines map[string]int
+}
+
+// File returns a Document which parses the file at path with unmarshal. The file
+// must contain a single object, e.g. a JSON object or YAML mapping.
+//
+// If the file does not exist, none of the settings of the Document are set.
+func File(path string, unmarshal UnmarshalFunc) *Document {
+	return newDocument(path, unmarshal, nil)
+}
+
+// newDocument returns a Document which parses the file at path with unmarshal,
+// finding the lines its settings are defined on with lines, if not nil.
+func newDocument(path string, unmarshal UnmarshalFunc, lines func([]byte) map[string]int) *Document {
+	cache := &fileCache[*parsedDocument]{
+		path: path,
+		parse: func(data []byte) (*parsedDocument, error) {
+			var doc map[string]any
+			err := unmarshal(data, &doc)
+			if err != nil {
+				return nil, fmt.Errorf("config: parsing %s: %w", path, err)
+			}
+
+			m, _ := normalize(doc).(map[string]any)
+			parsed := &parsedDocument{tree: m}
+			if lines != nil {
+				parsed.lines = lines(data)
+			}
+			return parsed, nil
+		},
+	}
+	return &Document{
+		path:  path,
+		parse: cache.load,
+	}
+}
+
+// fileCache parses the file at path on first use, and again whenever its size or
+// modification time changes. Only successful parses are cached.
+type fileCache[T any] struct {
+	path  string
+	parse func([]byte) (T, error)
+
+	mu      sync.Mutex
+	cached  bool
+	val     T
+	size    int64
+	modTime time.Time
+}
+
+// load returns the parsed file, or the zero value of T if it does not exist.
+func (c *fileCache[T]) load() (T, error) {
+	var zero T
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	f, err := os.Open(c.path)
+	if err != nil {
+		c.cached = false
+		if os.IsNotExist(err) {
+			return zero, nil
+		}
+		return zero, fmt.Errorf("config: reading %s: %w", c.path, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return zero, fmt.Errorf("config: reading %s: %w", c.path, err)
+	}
+	if c.cached && info.Size() == c.size && info.ModTime().Equal(c.modTime) {
+		return c.val, nil
+	}
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return zero, fmt.Errorf("config: reading %s: %w", c.path, err)
+	}
+	val, err := c.parse(data)
+	if err != nil {
+		return zero, err
+	}
+
+	c.cached = true
+	c.val = val
+	c.size = info.Size()
+	c.modTime = info.ModTime()
+	return val, nil
+}
+
+// JSONFile returns a Document which parses the JSON file at path.
+func JSONFile(path string) *Document {
+	return newDocument(path, json.Unmarshal, jsonLines)
+}
+
+// YAMLFile returns a Document which parses the YAML file at path.
+func YAMLFile(path string) *Document {
+	return newDocument(path, yaml.Unmarshal, yamlLines)
+}
+
+// TOMLFile returns a Document which parses the TOML file at path. The lines
+// settings are defined on are not recorded in their [Source].
+func TOMLFile(path string) *Document {
+	return File(path, toml.Unmarshal)
+}
+
+// Path returns a Reader which reads the setting at path within doc. The path is a
+// dot separated list of keys, e.g. "server.http.read_timeout", where keys of
+// arrays are indexes, e.g. "servers.0.host".
+//
+// The Value is not set if the file does not exist, or the setting is missing or null.
+//
+// Settings are converted to T as follows:
+//   - values already of type T are returned as is, e.g. a YAML timestamp read as a time.Time
+//   - strings are parsed the same way as fields read by [Struct], so time.Durations can be
+//     written as "5s" and types implementing encoding.TextUnmarshaler are supported
+//   - anything else, including tables and arrays, is converted as if it were
+//     JSON decoded into T, so structs use their json tags
+func Path[T any](doc *Document, path string) Reader[T] {
+	return ReaderFunc[T](func(ctx context.Context) (Value[T], error) {
+		parsed, err := doc.parse()
+		if err != nil || parsed == nil {
+			return Value[T]{}, err
+		}
+
+		src := Source{
+			Kind: SourceFile,
+			Name: path,
+			File: doc.path,
+			Line: parsed.lines[path],
+		}
+		node, ok := lookupPath(parsed.tree, path)
+		if !ok || node == nil {
+			return Value[T]{src: src}, nil
+		}
+
+		v, err := convertNode[T](node)
+		if err != nil {
+			return Value[T]{}, withSource(src, fmt.Errorf("config: %s: %s: %w", doc.path, path, err))
+		}
+		return ValueOf(v).WithSource(src), nil
+	})
+}
+
+// lookupPath walks the dot separated keys of path through doc.
+func lookupPath(doc map[string]any, path string) (any, bool) {
+	var node any = doc
+	for key := range strings.SplitSeq(path, ".") {
+		switch n := node.(type) {
+		case map[string]any:
+			v, ok := n[key]
+			if !ok {
+				return nil, false
+			}
+			node = v
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(n) {
+				return nil, false
+			}
+			node = n[i]
+		default:
+			return nil, false
+		}
+	}
+	return node, true
+}
+
+// joinPath appends key to the dot separated path.
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// jsonLines returns the lines the settings of a JSON document are defined on.
+func jsonLines(data []byte) map[string]int {
+	lines := make(map[string]int)
+	lineAt := func(offset int64) int {
+		return 1 + bytes.Count(data[:offset], []byte("\n"))
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	var walk func(path string) error
+	walk = func(path string) error {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		switch tok {
+		case json.Delim('{'):
+			for dec.More() {
+				tok, err := dec.Token()
+				if err != nil {
+					return err
+				}
+				key := joinPath(path, tok.(string))
+				lines[key] = lineAt(dec.InputOffset())
+
+				err = walk(key)
+				if err != nil {
+					return err
+				}
+			}
+		case json.Delim('['):
+			for i := 0; dec.More(); i++ {
+				// The offset is that of the end of the previous token, so skip
+				// past the separator to find the start of the element.
+				offset := dec.InputOffset()
+				for offset < int64(len(data)) && strings.IndexByte(" \t\r\n,", data[offset]) >= 0 {
+					offset++
+				}
+				key := joinPath(path, strconv.Itoa(i))
+				lines[key] = lineAt(offset)
+
+				err := walk(key)
+				if err != nil {
+					return err
+				}
+			}
+		default:
+			return nil
+		}
+
+		// Consume the closing delimiter.
+		_, err = dec.Token()
+		return err
+	}
+
+	// The document has already been parsed, so any error was already reported.
+	walk("")
+	return lines
+}
+
+// yamlLines returns the lines the settings of a YAML document are defined on.
+func yamlLines(data []byte) map[string]int {
+	var root yaml.Node
+	if yaml.Unmarshal(data, &root) != nil {
+		return nil
+	}
+
+	lines := make(map[string]int)
+	var walk func(n *yaml.Node, path string)
+	walk = func(n *yaml.Node, path string) {
+		switch n.Kind {
+		case yaml.DocumentNode:
+			for _, c := range n.Content {
+				walk(c, path)
+			}
+		case yaml.MappingNode:
+			for i := 0; i+1 < len(n.Content); i += 2 {
+				key := joinPath(path, n.Content[i].Value)
+				lines[key] = n.Content[i].Line
+				walk(n.Content[i+1], key)
+			}
+		case yaml.SequenceNode:
+			for i, c := range n.Content {
+				key := joinPath(path, strconv.Itoa(i))
+				lines[key] = c.Line
+				walk(c, key)
+			}
+		}
+	}
+	walk(&root, "")
+	return lines
+}
+
+// normalize converts every map within v to a map[string]any and every slice to a
+// []any, since decoders disagree on how they represent them, e.g. YAML mappings
+// with non-string keys or TOML arrays of tables.
+func normalize(v any) any {
+	if v == nil {
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Map:
+		m := make(map[string]any, rv.Len())
+		iter := rv.MapRange()
+		for iter.Next() {
+			m[fmt.Sprint(iter.Key().Interface())] = normalize(iter.Value().Interface())
+		}
+		return m
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return v
+		}
+		s := make([]any, rv.Len())
+		for i := range s {
+			s[i] = normalize(rv.Index(i).Interface())
+		}
+		return s
+	default:
+		return v
+	}
+}
+
+func convertNode[T any](node any) (T, error) {
+	if v, ok := node.(T); ok {
+		return v, nil
+	}
+
+	var v T
+	if s, ok := node.(string); ok {
+		err := setValue(reflect.ValueOf(&v).Elem(), s, ",")
+		return v, err
+	}
+
+	b, err := json.Marshal(node)
+	if err != nil {
+		return v, err
+	}
+	err = json.Unmarshal(b, &v)
+	return v, err
+}
@@ -0,0 +1,285 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	err := os.WriteFile(path, []byte(content), 0o600)
+	require.NoError(t, err)
+	return path
+}
+
+const jsonDoc = `{
+	"server": {
+		"http": {"port": 8080, "read_timeout": "5s", "hosts": ["a", "b"]},
+		"tls": null
+	},
+	"servers": [{"host": "a", "port": 1}, {"host": "b", "port": 2}]
+}`
+
+const yamlDoc = `
+server:
+  http:
+    port: 8080
+    read_timeout: 5s
+    hosts: [a, b]
+  tls: ~
+servers:
+  - host: a
+    port: 1
+  - host: b
+    port: 2
+`
+
+const tomlDoc = `
+servers = [{ host = "a", port = 1 }, { host = "b", port = 2 }]
+
+[server.http]
+port = 8080
+read_timeout = "5s"
+hosts = ["a", "b"]
+`
+
+type serverConfig struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+func TestPath(t *testing.T) {
+	testCases := []struct {
+		name string
+		doc  func(t *testing.T) *Document
+	}{
+		{
+			name: "json",
+			doc: func(t *testing.T) *Document {
+				return JSONFile(writeFile(t, "config.json", jsonDoc))
+			},
+		},
+		{
+			name: "yaml",
+			doc: func(t *testing.T) *Document {
+				return YAMLFile(writeFile(t, "config.yaml", yamlDoc))
+			},
+		},
+		{
+			name: "toml",
+			doc: func(t *testing.T) *Document {
+				return TOMLFile(writeFile(t, "config.toml", tomlDoc))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			doc := tc.doc(t)
+
+			port, err := Read(ctx, Path[int](doc, "server.http.port"))
+			require.NoError(t, err)
+			require.Equal(t, 8080, port)
+
+			timeout, err := Read(ctx, Path[time.Duration](doc, "server.http.read_timeout"))
+			require.NoError(t, err)
+			require.Equal(t, 5*time.Second, timeout)
+
+			hosts, err := Read(ctx, Path[[]string](doc, "server.http.hosts"))
+			require.NoError(t, err)
+			require.Equal(t, []string{"a", "b"}, hosts)
+
+			host, err := Read(ctx, Path[string](doc, "servers.1.host"))
+			require.NoError(t, err)
+			require.Equal(t, "b", host)
+
+			servers, err := Read(ctx, Path[[]serverConfig](doc, "servers"))
+			require.NoError(t, err)
+			require.Equal(t, []serverConfig{{Host: "a", Port: 1}, {Host: "b", Port: 2}}, servers)
+
+			for _, path := range []string{"server.tls", "server.grpc.port", "servers.2.host", "servers.x", "server.http.port.x"} {
+				val, err := Path[string](doc, path).Read(ctx)
+				require.NoError(t, err)
+
+				_, ok := val.Value()
+				require.False(t, ok, path)
+			}
+		})
+	}
+}
+
+func TestPath_LayeredWithEnv(t *testing.T) {
+	doc := JSONFile(writeFile(t, "config.json", `{"server": {"port": 8080}}`))
+	port := Or(IntFromString(Env("TEST_PATH_PORT")), Path[int](doc, "server.port"))
+
+	val, err := Read(context.Background(), port)
+	require.NoError(t, err)
+	require.Equal(t, 8080, val)
+
+	t.Setenv("TEST_PATH_PORT", "9090")
+
+	val, err = Read(context.Background(), port)
+	require.NoError(t, err)
+	require.Equal(t, 9090, val)
+}
+
+func TestPath_Errors(t *testing.T) {
+	t.Run("missing file is not set", func(t *testing.T) {
+		doc := JSONFile(filepath.Join(t.TempDir(), "missing.json"))
+
+		val, err := Path[int](doc, "server.port").Read(context.Background())
+		require.NoError(t, err)
+
+		_, ok := val.Value()
+		require.False(t, ok)
+	})
+
+	t.Run("malformed file", func(t *testing.T) {
+		path := writeFile(t, "config.json", `{"server":`)
+
+		_, err := Path[int](JSONFile(path), "server.port").Read(context.Background())
+		require.ErrorContains(t, err, "config: parsing "+path)
+	})
+
+	t.Run("mistyped setting", func(t *testing.T) {
+		path := writeFile(t, "config.json", `{"server": {"port": "http", "tls": true}}`)
+		doc := JSONFile(path)
+
+		_, err := Path[int](doc, "server.port").Read(context.Background())
+		require.ErrorContains(t, err, "config: "+path+": server.port: ")
+
+		_, err = Path[string](doc, "server.tls").Read(context.Background())
+		require.ErrorContains(t, err, "config: "+path+": server.tls: ")
+	})
+
+	t.Run("parses the file once", func(t *testing.T) {
+		calls := 0
+		doc := File(writeFile(t, "config", "port"), func(data []byte, v any) error {
+			calls++
+			*(v.(*map[string]any)) = map[string]any{string(data): 1}
+			return nil
+		})
+
+		for range 3 {
+			port, err := Read(context.Background(), Path[int](doc, "port"))
+			require.NoError(t, err)
+			require.Equal(t, 1, port)
+		}
+		require.Equal(t, 1, calls)
+	})
+
+	t.Run("recovers from a read error", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.json")
+		require.NoError(t, os.Mkdir(path, 0o700))
+		doc := JSONFile(path)
+
+		_, err := Path[int](doc, "server.port").Read(context.Background())
+		require.ErrorContains(t, err, "config: reading "+path)
+
+		require.NoError(t, os.Remove(path))
+		require.NoError(t, os.WriteFile(path, []byte(`{"server": {"port": 8080}}`), 0o600))
+
+		port, err := Read(context.Background(), Path[int](doc, "server.port"))
+		require.NoError(t, err)
+		require.Equal(t, 8080, port)
+	})
+
+	t.Run("recovers from a malformed file", func(t *testing.T) {
+		path := writeFile(t, "config.json", `{"server":`)
+		doc := JSONFile(path)
+
+		_, err := Path[int](doc, "server.port").Read(context.Background())
+		require.ErrorContains(t, err, "config: parsing "+path)
+
+		require.NoError(t, os.WriteFile(path, []byte(`{"server": {"port": 8080}}`), 0o600))
+
+		port, err := Read(context.Background(), Path[int](doc, "server.port"))
+		require.NoError(t, err)
+		require.Equal(t, 8080, port)
+	})
+
+	t.Run("rereads a changed file", func(t *testing.T) {
+		path := writeFile(t, "config.json", `{"port": 8080}`)
+		doc := JSONFile(path)
+
+		port, err := Read(context.Background(), Path[int](doc, "port"))
+		require.NoError(t, err)
+		require.Equal(t, 8080, port)
+
+		require.NoError(t, os.WriteFile(path, []byte(`{"port": 9090}`), 0o600))
+		modTime := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+
+		port, err = Read(context.Background(), Path[int](doc, "port"))
+		require.NoError(t, err)
+		require.Equal(t, 9090, port)
+	})
+}
+
+func TestPath_Source(t *testing.T) {
+	testCases := []struct {
+		name     string
+		file     string
+		content  string
+		open     func(path string) *Document
+		expected int
+	}{
+		{
+			name:     "json",
+			file:     "config.json",
+			content:  jsonDoc,
+			open:     JSONFile,
+			expected: 6,
+		},
+		{
+			name:     "yaml",
+			file:     "config.yaml",
+			content:  yamlDoc,
+			open:     YAMLFile,
+			expected: 11,
+		},
+		{
+			name:     "toml",
+			file:     "config.toml",
+			content:  tomlDoc,
+			open:     TOMLFile,
+			expected: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := writeFile(t, tc.file, tc.content)
+
+			val, err := Path[string](tc.open(path), "servers.1.host").Read(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, Source{
+				Kind: SourceFile,
+				Name: "servers.1.host",
+				File: path,
+				Line: tc.expected,
+			}, val.Source())
+		})
+	}
+
+	t.Run("json keys", func(t *testing.T) {
+		doc := JSONFile(writeFile(t, "config.json", jsonDoc))
+
+		val, err := Path[int](doc, "server.http.port").Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 3, val.Source().Line)
+	})
+}
@@ -0,0 +1,236 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// ErrFlagsNotParsed is returned when reading a flag before its FlagSet has been parsed.
+var ErrFlagsNotParsed = errors.New("config: flags have not been parsed")
+
+// checkConfigFlag is the name of the flag which bedrock.Main checks for, see
+// bedrock.CheckConfigRequested.
+const checkConfigFlag = "check-config"
+
+// Flags is a source of configuration values set by command line flags, whose
+// flags are defined by [Flag].
+type Flags struct {
+	fs   *flag.FlagSet
+	meta map[string]flagMeta
+}
+
+type flagMeta struct {
+	typeName string
+	env      string
+	def      string
+}
+
+// NewFlags returns Flags which defines its flags in fs. The usage message of fs,
+// printed for -h or --help, is replaced by one which also lists the environment
+// variable and default of each flag defined by [Flag].
+//
+// NewFlags also defines the --check-config flag, unless fs already defines it, so
+// parsing fs does not fail when bedrock.Main is asked to check the configuration.
+func NewFlags(fs *flag.FlagSet) *Flags {
+	f := &Flags{
+		fs:   fs,
+		meta: make(map[string]flagMeta),
+	}
+	if fs.Lookup(checkConfigFlag) == nil {
+		fs.Bool(checkConfigFlag, false, "check the configuration and exit")
+	}
+	fs.Usage = func() {
+		f.WriteUsage(fs.Output())
+	}
+	return f
+}
+
+// Parse parses the flags from args, which should not include the name of the
+// program, e.g. os.Args[1:].
+func (f *Flags) Parse(args []string) error {
+	return f.fs.Parse(args)
+}
+
+// FlagOption describes a flag defined by [Flag] in its usage message.
+type FlagOption func(*flagMeta)
+
+// FlagEnv lists the environment variable name, which the flag overrides, in the
+// usage message of the flag.
+func FlagEnv(name string) FlagOption {
+	return func(m *flagMeta) {
+		m.env = name
+	}
+}
+
+// FlagDefault lists the default value v, which is used when neither the flag nor
+// any other source is set, in the usage message of the flag.
+func FlagDefault(v any) FlagOption {
+	return func(m *flagMeta) {
+		m.def = fmt.Sprint(v)
+	}
+}
+
+// Flag defines a flag named name in f and returns a Reader which reads its value.
+// The Value is only set if the flag was given explicitly on the command line, so
+// flags can be layered in front of other sources with [Or]:
+//
+//	flags := config.NewFlags(flag.CommandLine)
+//	port := config.Default(8080, config.Or(
+//	    config.Flag[int](flags, "port", "port to listen on", config.FlagEnv("PORT"), config.FlagDefault(8080)),
+//	    config.IntFromString(config.Env("PORT")),
+//	    config.Path[int](file, "server.port"),
+//	))
+//
+// Values are parsed the same way as fields read by [Struct], except that slice flags
+// may also be given multiple times, appending to the slice. Boolean flags may be
+// given without a value, e.g. --debug.
+//
+// Reading the flag before f has been parsed returns [ErrFlagsNotParsed]. Like
+// flag.FlagSet, Flag panics if the flag has already been defined.
+func Flag[T any](f *Flags, name, usage string, opts ...FlagOption) Reader[T] {
+	meta := flagMeta{typeName: flagTypeName(reflect.TypeFor[T]())}
+	for _, opt := range opts {
+		opt(&meta)
+	}
+
+	v := &flagValue[T]{}
+	f.fs.Var(v, name, usage)
+	f.meta[name] = meta
+
+	return ReaderFunc[T](func(ctx context.Context) (Value[T], error) {
+		if !f.fs.Parsed() {
+			return Value[T]{}, ErrFlagsNotParsed
+		}
+		src := Source{Kind: SourceFlag, Name: name}
+		if !v.set {
+			return Value[T]{src: src}, nil
+		}
+		return ValueOf(v.val).WithSource(src), nil
+	})
+}
+
+// flagTypeName returns the name of the type of a flag's value in its usage message.
+func flagTypeName(t reflect.Type) string {
+	switch {
+	case t == durationType:
+		return "duration"
+	case t.Kind() == reflect.Bool:
+		return ""
+	default:
+		return t.String()
+	}
+}
+
+// flagValue implements flag.Value for a flag defined by Flag.
+type flagValue[T any] struct {
+	val T
+	raw string
+	set bool
+}
+
+// String implements the [flag.Value] interface.
+func (v *flagValue[T]) String() string {
+	return v.raw
+}
+
+// Set implements the [flag.Value] interface.
+func (v *flagValue[T]) Set(s string) error {
+	var t T
+	rv := reflect.ValueOf(&t).Elem()
+	err := setValue(rv, s, ",")
+	if err != nil {
+		return err
+	}
+
+	if v.set && rv.Kind() == reflect.Slice {
+		rv.Set(reflect.AppendSlice(reflect.ValueOf(v.val), rv))
+		s = v.raw + "," + s
+	}
+
+	v.val = t
+	v.raw = s
+	v.set = true
+	return nil
+}
+
+// IsBoolFlag allows boolean flags to be given without a value.
+func (v *flagValue[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
+
+// WriteUsage writes the usage message of the flags to w, listing every flag of
+// the FlagSet along with its environment variable and default, if known.
+func (f *Flags) WriteUsage(w io.Writer) error {
+	var sb strings.Builder
+	if f.fs.Name() == "" {
+		sb.WriteString("Usage:\n")
+	} else {
+		fmt.Fprintf(&sb, "Usage of %s:\n", f.fs.Name())
+	}
+
+	f.fs.VisitAll(func(fl *flag.Flag) {
+		typeName, usage := flag.UnquoteUsage(fl)
+		meta, ok := f.meta[fl.Name]
+		if ok {
+			typeName = meta.typeName
+		} else if !isZeroDefault(fl) {
+			meta.def = fl.DefValue
+		}
+
+		sb.WriteString("  --")
+		sb.WriteString(fl.Name)
+		if typeName != "" {
+			sb.WriteString(" ")
+			sb.WriteString(typeName)
+		}
+		sb.WriteString("\n    \t")
+		sb.WriteString(strings.ReplaceAll(usage, "\n", "\n    \t"))
+
+		var details []string
+		if meta.env != "" {
+			details = append(details, "env "+meta.env)
+		}
+		if meta.def != "" {
+			details = append(details, "default "+meta.def)
+		}
+		if len(details) > 0 {
+			sb.WriteString(" (")
+			sb.WriteString(strings.Join(details, ", "))
+			sb.WriteString(")")
+		}
+		sb.WriteString("\n")
+	})
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// isZeroDefault reports whether the default of a flag not defined by Flag is the
+// zero value of its type, in which case flag.PrintDefaults omits it as well.
+func isZeroDefault(fl *flag.Flag) (zero bool) {
+	t := reflect.TypeOf(fl.Value)
+	var v reflect.Value
+	if t.Kind() == reflect.Pointer {
+		v = reflect.New(t.Elem())
+	} else {
+		v = reflect.Zero(t)
+	}
+
+	// Some flag.Value implementations panic when their zero value is formatted.
+	defer func() {
+		if recover() != nil {
+			zero = false
+		}
+	}()
+	return fl.DefValue == v.Interface().(flag.Value).String()
+}
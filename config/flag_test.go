@@ -0,0 +1,193 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFlags() *Flags {
+	fs := flag.NewFlagSet("app", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	return NewFlags(fs)
+}
+
+func TestFlag(t *testing.T) {
+	flags := newTestFlags()
+	port := Flag[int](flags, "port", "port to listen on")
+	timeout := Flag[time.Duration](flags, "timeout", "request timeout")
+	debug := Flag[bool](flags, "debug", "enable debug logging")
+	hosts := Flag[[]string](flags, "host", "allowed hosts")
+	name := Flag[string](flags, "name", "service name")
+
+	err := flags.Parse([]string{"--port", "9090", "-timeout=5s", "--debug", "--host", "a,b", "--host=c"})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	val, err := port.Read(ctx)
+	require.NoError(t, err)
+	require.Equal(t, Source{Kind: SourceFlag, Name: "port"}, val.Source())
+
+	p, ok := val.Value()
+	require.True(t, ok)
+	require.Equal(t, 9090, p)
+
+	d, err := Read(ctx, timeout)
+	require.NoError(t, err)
+	require.Equal(t, 5*time.Second, d)
+
+	b, err := Read(ctx, debug)
+	require.NoError(t, err)
+	require.True(t, b)
+
+	h, err := Read(ctx, hosts)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, h)
+
+	t.Run("flag not given is not set", func(t *testing.T) {
+		val, err := name.Read(ctx)
+		require.NoError(t, err)
+
+		_, ok := val.Value()
+		require.False(t, ok)
+	})
+}
+
+func TestFlag_Precedence(t *testing.T) {
+	doc := JSONFile(writeFile(t, "config.json", `{"port": 7070}`))
+
+	newPort := func(args ...string) Reader[int] {
+		flags := newTestFlags()
+		port := Default(8080, Or(
+			Flag[int](flags, "port", "port to listen on"),
+			IntFromString(Env("TEST_FLAG_PORT")),
+			Path[int](doc, "port"),
+		))
+
+		err := flags.Parse(args)
+		require.NoError(t, err)
+		return port
+	}
+
+	t.Run("flag", func(t *testing.T) {
+		t.Setenv("TEST_FLAG_PORT", "6060")
+
+		val, err := newPort("--port", "9090").Read(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, SourceFlag, val.Source().Kind)
+
+		p, _ := val.Value()
+		require.Equal(t, 9090, p)
+	})
+
+	t.Run("env", func(t *testing.T) {
+		t.Setenv("TEST_FLAG_PORT", "6060")
+
+		p, err := Read(context.Background(), newPort())
+		require.NoError(t, err)
+		require.Equal(t, 6060, p)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		p, err := Read(context.Background(), newPort())
+		require.NoError(t, err)
+		require.Equal(t, 7070, p)
+	})
+
+	t.Run("default", func(t *testing.T) {
+		doc = JSONFile(writeFile(t, "config.json", `{}`))
+
+		p, err := Read(context.Background(), newPort())
+		require.NoError(t, err)
+		require.Equal(t, 8080, p)
+	})
+}
+
+func TestFlag_Errors(t *testing.T) {
+	t.Run("not parsed", func(t *testing.T) {
+		port := Flag[int](newTestFlags(), "port", "port to listen on")
+
+		_, err := port.Read(context.Background())
+		require.ErrorIs(t, err, ErrFlagsNotParsed)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		flags := newTestFlags()
+		Flag[int](flags, "port", "port to listen on")
+
+		err := flags.Parse([]string{"--port", "http"})
+		require.ErrorContains(t, err, `invalid value "http" for flag -port`)
+	})
+}
+
+func TestNewFlags_CheckConfig(t *testing.T) {
+	t.Run("defines the check-config flag", func(t *testing.T) {
+		flags := newTestFlags()
+		port := Flag[int](flags, "port", "port to listen on")
+
+		err := flags.Parse([]string{"--check-config", "--port", "9090"})
+		require.NoError(t, err)
+
+		p, err := Read(context.Background(), port)
+		require.NoError(t, err)
+		require.Equal(t, 9090, p)
+	})
+
+	t.Run("keeps an existing check-config flag", func(t *testing.T) {
+		fs := flag.NewFlagSet("app", flag.ContinueOnError)
+		checkConfig := fs.Bool("check-config", false, "validate and exit")
+
+		require.NotPanics(t, func() {
+			NewFlags(fs)
+		})
+		require.NoError(t, fs.Parse([]string{"--check-config"}))
+		require.True(t, *checkConfig)
+	})
+}
+
+func TestFlags_WriteUsage(t *testing.T) {
+	flags := newTestFlags()
+	Flag[int](flags, "port", "port to listen on", FlagEnv("PORT"), FlagDefault(8080))
+	Flag[time.Duration](flags, "timeout", "request timeout", FlagDefault(30*time.Second))
+	Flag[bool](flags, "debug", "enable debug logging", FlagEnv("DEBUG"))
+	flags.fs.String("mode", "fast", "the `speed` to run at")
+	flags.fs.Bool("verbose", false, "verbose output")
+
+	var buf bytes.Buffer
+	err := flags.WriteUsage(&buf)
+	require.NoError(t, err)
+	require.Equal(t, `Usage of app:
+  --check-config
+    	check the configuration and exit
+  --debug
+    	enable debug logging (env DEBUG)
+  --mode speed
+    	the speed to run at (default fast)
+  --port int
+    	port to listen on (env PORT, default 8080)
+  --timeout duration
+    	request timeout (default 30s)
+  --verbose
+    	verbose output
+`, buf.String())
+
+	t.Run("is printed for --help", func(t *testing.T) {
+		var out bytes.Buffer
+		flags.fs.SetOutput(&out)
+
+		err := flags.Parse([]string{"--help"})
+		require.ErrorIs(t, err, flag.ErrHelp)
+		require.Equal(t, buf.String(), out.String())
+	})
+}
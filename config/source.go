@@ -0,0 +1,294 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+)
+
+// SourceKind is the kind of source a configuration value was read from.
+type SourceKind int
+
+const (
+	// SourceUnknown is the kind of values whose source is not known, e.g. those
+	// returned by [ReaderOf].
+	SourceUnknown SourceKind = iota
+
+	// SourceEnv is the kind of values read from environment variables.
+	SourceEnv
+
+	// SourceFile is the kind of values read from files.
+	SourceFile
+
+	// SourceDefault is the kind of default values, e.g. those returned by [Default].
+	SourceDefault
+
+	// SourceFlag is the kind of values read from command line flags.
+	SourceFlag
+)
+
+// String returns the name of the kind, e.g. "env".
+func (k SourceKind) String() string {
+	switch k {
+	case SourceEnv:
+		return "env"
+	case SourceFile:
+		return "file"
+	case SourceDefault:
+		return "default"
+	case SourceFlag:
+		return "flag"
+	default:
+		return "unknown"
+	}
+}
+
+// Source describes where a configuration value was read from. The source of a
+// value is passed through the Readers which combine it, e.g. [Or] and [Map], so
+// the source of the value returned by Or is the source of the Reader which set it.
+//
+// Values which are not set also have a source if their Reader only reads a single
+// one, e.g. [Env], so errors about missing values can name the setting to set.
+type Source struct {
+	// Kind is the kind of source.
+	Kind SourceKind
+
+	// Name is the name of the value within its source, e.g. the name of an
+	// environment variable or flag, or the path of a setting within a file.
+	Name string
+
+	// File is the path of the file the value was read from, if any.
+	File string
+
+	// Line is the line of File the value was read from, or zero if unknown.
+	Line int
+}
+
+// String returns a human readable description of the source, e.g.
+// "env PORT" or "file config.yaml:12 server.port".
+func (s Source) String() string {
+	var sb strings.Builder
+	sb.WriteString(s.Kind.String())
+	if s.File != "" {
+		sb.WriteString(" ")
+		sb.WriteString(s.File)
+		if s.Line > 0 {
+			sb.WriteString(":")
+			sb.WriteString(strconv.Itoa(s.Line))
+		}
+	}
+	if s.Name != "" {
+		sb.WriteString(" ")
+		sb.WriteString(s.Name)
+	}
+	return sb.String()
+}
+
+// SourceError reports an error reading a value from its source, e.g. an environment
+// variable whose value cannot be parsed. Its message is that of the error it wraps.
+type SourceError struct {
+	// Source is where the value was read from.
+	Source Source
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error implements the [error] interface.
+func (e *SourceError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
+
+// withSource wraps err in a *SourceError, unless src is unknown.
+func withSource(src Source, err error) error {
+	if src.Kind == SourceUnknown {
+		return err
+	}
+	return &SourceError{Source: src, Err: err}
+}
+
+// Secret returns a Reader which marks the values read by r as secrets, so they are
+// redacted from a [Report].
+func Secret[T any](r Reader[T]) Reader[T] {
+	return ReaderFunc[T](func(ctx context.Context) (Value[T], error) {
+		val, err := r.Read(ctx)
+		if err != nil {
+			return Value[T]{}, err
+		}
+
+		val.secret = true
+		return val, nil
+	})
+}
+
+// Redacted replaces the values of secrets in a [Report].
+const Redacted = "[REDACTED]"
+
+// ReportEntry is a configuration value recorded in a [Report].
+type ReportEntry struct {
+	// Key is the name the value was recorded with.
+	Key string
+
+	// Value is the value formatted as a string, or [Redacted] if it is a secret.
+	// Only scalars, and pointers to, slices of and maps of scalars, are formatted.
+	// Other values, such as structs, are replaced by their type, e.g. "<*tls.Config>".
+	Value string
+
+	// Source is where the value was read from.
+	Source Source
+}
+
+// Report collects the configuration values read while starting an application,
+// along with where they were read from, e.g. to log which source set each value.
+type Report struct {
+	mu      sync.Mutex
+	entries []ReportEntry
+}
+
+type reportKey struct{}
+
+// WithReport returns a copy of ctx carrying a new Report, which every value
+// recorded with [Record] using the returned context is added to.
+func WithReport(ctx context.Context) (context.Context, *Report) {
+	r := &Report{}
+	return context.WithValue(ctx, reportKey{}, r), r
+}
+
+type reportPrefixKey struct{}
+
+// WithReportPrefix returns a copy of ctx under which the keys of the values recorded
+// with [Record] are qualified by prefix, e.g. "http.Runtime/TCPListener.addr" for the
+// key "addr", so the values of different components read under the same key can be
+// told apart. A prefix given to a context already carrying one replaces it.
+func WithReportPrefix(ctx context.Context, prefix string) context.Context {
+	return context.WithValue(ctx, reportPrefixKey{}, prefix)
+}
+
+// Record adds v to the Report carried by ctx, if any, under the name key, qualified
+// by the prefix carried by ctx, see [WithReportPrefix]. Values which are not set or
+// whose source is unknown are not recorded. In particular, the values read by [Struct]
+// are not recorded, since Struct records each of their fields instead.
+func Record[T any](ctx context.Context, key string, v Value[T]) {
+	r, ok := ctx.Value(reportKey{}).(*Report)
+	if !ok || !v.set || v.src.Kind == SourceUnknown {
+		return
+	}
+	if prefix, _ := ctx.Value(reportPrefixKey{}).(string); prefix != "" {
+		key = prefix + "." + key
+	}
+	r.add(key, v.val, v.src, v.secret)
+}
+
+func (r *Report) add(key string, val any, src Source, secret bool) {
+	entry := ReportEntry{
+		Key:    key,
+		Value:  Redacted,
+		Source: src,
+	}
+	if !secret {
+		entry.Value = formatValue(val)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, entry)
+}
+
+// formatValue formats val if it is a scalar, or a pointer to, slice of or map of
+// scalars. Any other value, e.g. a *tls.Config, could contain anything from private
+// keys to credentials, so only its type is formatted, e.g. "<*tls.Config>".
+func formatValue(val any) string {
+	rv := reflect.ValueOf(val)
+	if !rv.IsValid() {
+		return "<nil>"
+	}
+	if !isPrintable(rv.Type()) {
+		return "<" + rv.Type().String() + ">"
+	}
+
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return "<nil>"
+		}
+		rv = rv.Elem()
+	}
+	return fmt.Sprint(rv.Interface())
+}
+
+// isPrintable reports whether values of type t are safe to format in a [Report].
+func isPrintable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Array:
+		return isScalar(t.Elem())
+	case reflect.Map:
+		return isScalar(t.Key()) && isScalar(t.Elem())
+	default:
+		return isScalar(t)
+	}
+}
+
+func isScalar(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// Entries returns every recorded value, in the order they were recorded.
+func (r *Report) Entries() []ReportEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.entries)
+}
+
+// WriteReport writes a human readable table of the recorded values to w.
+func (r *Report) WriteReport(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	_, err := io.WriteString(tw, "KEY\tVALUE\tSOURCE\n")
+	for _, e := range r.Entries() {
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(tw, e.Key+"\t"+e.Value+"\t"+e.Source.String()+"\n")
+	}
+	if err != nil {
+		return err
+	}
+	return tw.Flush()
+}
+
+// LogValue implements [slog.LogValuer], logging each recorded value as a group
+// of its value and source, named after its key.
+func (r *Report) LogValue() slog.Value {
+	entries := r.Entries()
+	attrs := make([]slog.Attr, len(entries))
+	for i, e := range entries {
+		attrs[i] = slog.Group(e.Key,
+			slog.String("value", e.Value),
+			slog.String("source", e.Source.String()),
+		)
+	}
+	return slog.GroupValue(attrs...)
+}
@@ -0,0 +1,279 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSource_String(t *testing.T) {
+	testCases := []struct {
+		name     string
+		src      Source
+		expected string
+	}{
+		{name: "unknown", src: Source{}, expected: "unknown"},
+		{name: "env", src: Source{Kind: SourceEnv, Name: "PORT"}, expected: "env PORT"},
+		{name: "file", src: Source{Kind: SourceFile, File: "cert.pem"}, expected: "file cert.pem"},
+		{name: "file setting", src: Source{Kind: SourceFile, Name: "server.port", File: "config.yaml", Line: 12}, expected: "file config.yaml:12 server.port"},
+		{name: "default", src: Source{Kind: SourceDefault}, expected: "default"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, tc.src.String())
+		})
+	}
+}
+
+func TestValue_Source(t *testing.T) {
+	t.Setenv("TEST_SOURCE_PORT", "9090")
+	env := Source{Kind: SourceEnv, Name: "TEST_SOURCE_PORT"}
+
+	testCases := []struct {
+		name     string
+		reader   Reader[int]
+		expected Source
+	}{
+		{
+			name:     "literal",
+			reader:   ReaderOf(8080),
+			expected: Source{},
+		},
+		{
+			name:     "mapped",
+			reader:   IntFromString(Env("TEST_SOURCE_PORT")),
+			expected: env,
+		},
+		{
+			name:     "first set reader of Or",
+			reader:   Or(IntFromString(Env("TEST_SOURCE_UNSET")), IntFromString(Env("TEST_SOURCE_PORT")), ReaderOf(8080)),
+			expected: env,
+		},
+		{
+			name:     "set value of Default",
+			reader:   Default(8080, IntFromString(Env("TEST_SOURCE_PORT"))),
+			expected: env,
+		},
+		{
+			name:     "default value of Default",
+			reader:   Default(8080, IntFromString(Env("TEST_SOURCE_UNSET"))),
+			expected: Source{Kind: SourceDefault},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			val, err := tc.reader.Read(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, val.Source())
+		})
+	}
+}
+
+func TestSourceError(t *testing.T) {
+	t.Setenv("TEST_SOURCE_PORT", "http")
+	env := Source{Kind: SourceEnv, Name: "TEST_SOURCE_PORT"}
+
+	t.Run("wraps errors mapping a value", func(t *testing.T) {
+		_, err := IntFromString(Env("TEST_SOURCE_PORT")).Read(context.Background())
+
+		var srcErr *SourceError
+		require.ErrorAs(t, err, &srcErr)
+		require.Equal(t, env, srcErr.Source)
+		require.ErrorContains(t, err, `parsing "http"`)
+	})
+
+	t.Run("is not added for values whose source is unknown", func(t *testing.T) {
+		_, err := IntFromString(ReaderOf("http")).Read(context.Background())
+		require.Error(t, err)
+
+		var srcErr *SourceError
+		require.False(t, errors.As(err, &srcErr))
+	})
+
+	t.Run("values which are not set keep their source", func(t *testing.T) {
+		val, err := IntFromString(Env("TEST_SOURCE_UNSET")).Read(context.Background())
+		require.NoError(t, err)
+
+		_, ok := val.Value()
+		require.False(t, ok)
+		require.Equal(t, Source{Kind: SourceEnv, Name: "TEST_SOURCE_UNSET"}, val.Source())
+	})
+}
+
+func TestSecret(t *testing.T) {
+	t.Setenv("TEST_SOURCE_TOKEN", "hunter2")
+
+	val, err := Map(Secret(Env("TEST_SOURCE_TOKEN")), func(ctx context.Context, s string) ([]byte, error) {
+		return []byte(s), nil
+	}).Read(context.Background())
+	require.NoError(t, err)
+	require.True(t, val.IsSecret())
+	require.Equal(t, Source{Kind: SourceEnv, Name: "TEST_SOURCE_TOKEN"}, val.Source())
+}
+
+func TestReport(t *testing.T) {
+	t.Setenv("TEST_SOURCE_PORT", "9090")
+	t.Setenv("TEST_SOURCE_TOKEN", "hunter2")
+
+	ctx, report := WithReport(context.Background())
+	read := func(key string, r Reader[string]) {
+		val, err := r.Read(ctx)
+		require.NoError(t, err)
+		Record(ctx, key, val)
+	}
+
+	read("port", Env("TEST_SOURCE_PORT"))
+	read("token", Secret(Env("TEST_SOURCE_TOKEN")))
+	read("host", Default("localhost", Env("TEST_SOURCE_HOST")))
+	read("unset", Env("TEST_SOURCE_UNSET"))
+	read("literal", ReaderOf("value"))
+
+	expected := []ReportEntry{
+		{Key: "port", Value: "9090", Source: Source{Kind: SourceEnv, Name: "TEST_SOURCE_PORT"}},
+		{Key: "token", Value: Redacted, Source: Source{Kind: SourceEnv, Name: "TEST_SOURCE_TOKEN"}},
+		{Key: "host", Value: "localhost", Source: Source{Kind: SourceDefault}},
+	}
+	require.Equal(t, expected, report.Entries())
+
+	t.Run("WriteReport", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := report.WriteReport(&buf)
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Equal(t, []string{
+			"KEY    VALUE       SOURCE",
+			"port   9090        env TEST_SOURCE_PORT",
+			"token  [REDACTED]  env TEST_SOURCE_TOKEN",
+			"host   localhost   default",
+		}, lines)
+	})
+
+	t.Run("WriteReport returns write errors", func(t *testing.T) {
+		writeErr := errors.New("broken pipe")
+		err := report.WriteReport(failingWriter{err: writeErr})
+		require.ErrorIs(t, err, writeErr)
+	})
+
+	t.Run("LogValue", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				if len(groups) == 0 && a.Key == slog.TimeKey {
+					return slog.Attr{}
+				}
+				return a
+			},
+		}))
+		logger.Info("config", "config", report)
+
+		require.Equal(
+			t,
+			`level=INFO msg=config config.port.value=9090 config.port.source="env TEST_SOURCE_PORT" config.token.value=[REDACTED] config.token.source="env TEST_SOURCE_TOKEN" config.host.value=localhost config.host.source=default`+"\n",
+			buf.String(),
+		)
+	})
+
+	t.Run("WithReportPrefix", func(t *testing.T) {
+		ctx, report := WithReport(context.Background())
+		Record(WithReportPrefix(ctx, "http.Runtime/TCPListener"), "addr", ValueOf(":8080").WithSource(Source{Kind: SourceDefault}))
+
+		require.Equal(t, []ReportEntry{
+			{Key: "http.Runtime/TCPListener.addr", Value: ":8080", Source: Source{Kind: SourceDefault}},
+		}, report.Entries())
+	})
+
+	t.Run("without a report", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			Record(context.Background(), "port", ValueOf(8080).WithSource(Source{Kind: SourceDefault}))
+		})
+	})
+}
+
+// failingWriter fails every write with err.
+type failingWriter struct {
+	err error
+}
+
+func (w failingWriter) Write(p []byte) (int, error) {
+	return 0, w.err
+}
+
+func TestReport_FormatsOnlyScalars(t *testing.T) {
+	type credentials struct {
+		User     string
+		Password string
+	}
+
+	port := 8080
+	testCases := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{name: "string", value: "localhost", expected: "localhost"},
+		{name: "named scalar", value: 5 * time.Second, expected: "5s"},
+		{name: "pointer to scalar", value: &port, expected: "8080"},
+		{name: "nil pointer", value: (*int)(nil), expected: "<nil>"},
+		{name: "slice of scalars", value: []string{"a", "b"}, expected: "[a b]"},
+		{name: "map of scalars", value: map[string]int{"a": 1}, expected: "map[a:1]"},
+		{name: "struct", value: credentials{User: "admin", Password: "hunter2"}, expected: "<config.credentials>"},
+		{name: "pointer to struct", value: &credentials{Password: "hunter2"}, expected: "<*config.credentials>"},
+		{name: "slice of structs", value: []credentials{{Password: "hunter2"}}, expected: "<[]config.credentials>"},
+		{name: "func", value: func() string { return "hunter2" }, expected: "<func() string>"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, report := WithReport(context.Background())
+			Record(ctx, "key", ValueOf(tc.value).WithSource(Source{Kind: SourceFile, File: "config.json"}))
+
+			entries := report.Entries()
+			require.Len(t, entries, 1)
+			require.Equal(t, tc.expected, entries[0].Value)
+			require.NotContains(t, entries[0].Value, "hunter2")
+		})
+	}
+}
+
+func TestStruct_Report(t *testing.T) {
+	type config struct {
+		Port  int     `env:"PORT" default:"8080"`
+		Token string  `env:"TOKEN" secret:"true"`
+		Name  *string `env:"NAME"`
+		DB    struct {
+			Host string `env:"HOST"`
+		} `prefix:"DB_"`
+	}
+
+	t.Setenv("TEST_STRUCT_TOKEN", "hunter2")
+	t.Setenv("TEST_STRUCT_NAME", "api")
+	t.Setenv("TEST_STRUCT_DB_HOST", "db")
+
+	ctx, report := WithReport(context.Background())
+	r := Struct[config](Prefix("TEST_STRUCT_"))
+
+	val, err := r.Read(ctx)
+	require.NoError(t, err)
+	Record(ctx, "config", val)
+
+	require.Equal(t, []ReportEntry{
+		{Key: "Port", Value: "8080", Source: Source{Kind: SourceDefault}},
+		{Key: "Token", Value: Redacted, Source: Source{Kind: SourceEnv, Name: "TEST_STRUCT_TOKEN"}},
+		{Key: "Name", Value: "api", Source: Source{Kind: SourceEnv, Name: "TEST_STRUCT_NAME"}},
+		{Key: "DB.Host", Value: "db", Source: Source{Kind: SourceEnv, Name: "TEST_STRUCT_DB_HOST"}},
+	}, report.Entries())
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"context"
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LookupFunc returns the Reader of the raw value of the configuration setting
+// named key, e.g. [Env].
+type LookupFunc func(key string) Reader[string]
+
+// FieldError records which field of a struct read by [Struct] could not be set.
+type FieldError struct {
+	// Field is the path of the field within the struct, e.g. "DB.Port".
+	Field string
+
+	// Key is the name of the setting the field is read from, e.g. "DB_PORT".
+	Key string
+
+	// Err is the underlying error. It is [ErrValueNotSet] for a required
+	// field which is not set.
+	Err error
+}
+
+// Error implements the [error] interface.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("config: field %s (%s): %v", e.Field, e.Key, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// StructOption configures a [Struct] Reader.
+type StructOption func(*structOptions)
+
+type structOptions struct {
+	prefix string
+	lookup LookupFunc
+}
+
+// Prefix prepends p to the key of every field, e.g. "MYAPP_".
+func Prefix(p string) StructOption {
+	return func(o *structOptions) {
+		o.prefix = p
+	}
+}
+
+// Lookup sets where the values of fields are looked up. The default is [Env].
+func Lookup(f LookupFunc) StructOption {
+	return func(o *structOptions) {
+		o.lookup = f
+	}
+}
+
+var (
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	durationType        = reflect.TypeFor[time.Duration]()
+)
+
+// Struct returns a Reader which populates a struct of type T from the fields tagged
+// with the name of the setting they are read from, by default an environment variable:
+//
+//	type Config struct {
+//	    Port    int           `env:"PORT" default:"8080"`
+//	    Timeout time.Duration `env:"TIMEOUT" default:"5s"`
+//	    Hosts   []string      `env:"HOSTS" required:"true"`
+//	    DB      DBConfig      `prefix:"DB_"`
+//	}
+//
+// Fields may be strings, booleans, integers, floats, time.Durations or implement
+// encoding.TextUnmarshaler, as well as pointers to, slices of and maps of those.
+// Slice elements are separated by commas and map entries are comma separated key:value
+// pairs. A different separator can be given with the sep tag, e.g. `sep:";"`.
+//
+// A field which is not set takes the value of its default tag, if any. A field tagged
+// with `required:"true"` which is neither set nor has a default is an error.
+//
+// Untagged struct fields are read as nested structs, with the value of their prefix
+// tag prepended to the keys of their fields. Nested struct pointers are only allocated
+// if at least one of their fields is set or has a default. Untagged fields of any
+// other type, and unexported fields, are ignored, as are nested struct pointers to a
+// struct which is already being read, e.g. `Next *Node` within a Node.
+//
+// If ctx carries a [Report], each populated field is recorded in it under its path
+// within the struct, e.g. "DB.Port". Fields tagged with `secret:"true"` are redacted.
+//
+// Every field which is malformed, or required but not set, is reported as a
+// *FieldError, joined together into a single error. The returned Value is not set if
+// no field is set or has a default, so Struct can be combined with [Or] and [Default]
+// like any other Reader.
+func Struct[T any](opts ...StructOption) Reader[T] {
+	o := structOptions{lookup: Env}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return ReaderFunc[T](func(ctx context.Context) (Value[T], error) {
+		var v T
+		rv := reflect.ValueOf(&v).Elem()
+		if rv.Kind() != reflect.Struct {
+			return Value[T]{}, fmt.Errorf("config: Struct requires a struct type, got %s", rv.Type())
+		}
+
+		report, _ := ctx.Value(reportKey{}).(*Report)
+		d := &structDecoder{ctx: ctx, lookup: o.lookup, report: report}
+		populated := d.decodeStruct(rv, o.prefix, "")
+		if len(d.errs) > 0 {
+			return Value[T]{}, errors.Join(d.errs...)
+		}
+		if !populated {
+			return Value[T]{}, nil
+		}
+		return ValueOf(v), nil
+	})
+}
+
+// structDecoder populates structs, collecting every field error.
+type structDecoder struct {
+	ctx    context.Context
+	lookup LookupFunc
+	report *Report
+	errs   []error
+
+	// decoding holds the struct types currently being decoded, so
+	// self-referential types are not decoded forever.
+	decoding map[reflect.Type]bool
+}
+
+// decodeStruct populates the fields of rv, reporting whether any field was
+// populated, either from its setting or its default.
+func (d *structDecoder) decodeStruct(rv reflect.Value, prefix, path string) (populated bool) {
+	rt := rv.Type()
+	if d.decoding == nil {
+		d.decoding = make(map[reflect.Type]bool)
+	}
+	d.decoding[rt] = true
+	defer delete(d.decoding, rt)
+
+	for i := range rt.NumField() {
+		sf := rt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		fieldPath := sf.Name
+		if path != "" {
+			fieldPath = path + "." + sf.Name
+		}
+		fv := rv.Field(i)
+
+		key, tagged := sf.Tag.Lookup("env")
+		if !tagged {
+			if d.decodeNested(fv, prefix+sf.Tag.Get("prefix"), fieldPath) {
+				populated = true
+			}
+			continue
+		}
+		key = prefix + key
+
+		val, err := d.lookup(key).Read(d.ctx)
+		if err != nil {
+			d.errs = append(d.errs, &FieldError{Field: fieldPath, Key: key, Err: err})
+			continue
+		}
+		raw, ok := val.Value()
+		if !ok {
+			def, hasDefault := sf.Tag.Lookup("default")
+			if !hasDefault {
+				if sf.Tag.Get("required") == "true" {
+					d.errs = append(d.errs, &FieldError{Field: fieldPath, Key: key, Err: ErrValueNotSet})
+				}
+				continue
+			}
+			raw = def
+			val = ValueOf(def).WithSource(Source{Kind: SourceDefault})
+		}
+
+		sep := ","
+		if s, ok := sf.Tag.Lookup("sep"); ok {
+			sep = s
+		}
+
+		err = setValue(fv, raw, sep)
+		if err != nil {
+			d.errs = append(d.errs, &FieldError{Field: fieldPath, Key: key, Err: err})
+			continue
+		}
+		populated = true
+
+		if d.report != nil {
+			d.report.add(fieldPath, fv.Interface(), val.src, val.secret || sf.Tag.Get("secret") == "true")
+		}
+	}
+	return populated
+}
+
+// decodeNested populates an untagged field if it is a struct, or a pointer to one.
+func (d *structDecoder) decodeNested(fv reflect.Value, prefix, path string) bool {
+	switch {
+	case isLeaf(fv.Type()):
+		return false
+	case fv.Kind() == reflect.Struct:
+		return d.decodeStruct(fv, prefix, path)
+	case fv.Kind() == reflect.Pointer && fv.Type().Elem().Kind() == reflect.Struct && !isLeaf(fv.Type().Elem()):
+		if d.decoding[fv.Type().Elem()] {
+			return false
+		}
+		nested := reflect.New(fv.Type().Elem())
+		if !d.decodeStruct(nested.Elem(), prefix, path) {
+			return false
+		}
+		fv.Set(nested)
+		return true
+	default:
+		return false
+	}
+}
+
+// isLeaf reports whether values of type t are decoded from a single string,
+// even though t may be a struct, e.g. time.Time.
+func isLeaf(t reflect.Type) bool {
+	return t.Implements(textUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType)
+}
+
+// setValue parses raw into v.
+func setValue(v reflect.Value, raw, sep string) error {
+	if v.Kind() == reflect.Pointer {
+		elem := reflect.New(v.Type().Elem())
+		err := setValue(elem.Elem(), raw, sep)
+		if err != nil {
+			return err
+		}
+		v.Set(elem)
+		return nil
+	}
+
+	if reflect.PointerTo(v.Type()).Implements(textUnmarshalerType) {
+		return v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(raw))
+	}
+
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(raw, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		return setSlice(v, raw, sep)
+	case reflect.Map:
+		return setMap(v, raw, sep)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+func setSlice(v reflect.Value, raw, sep string) error {
+	var parts []string
+	if raw != "" {
+		parts = strings.Split(raw, sep)
+	}
+
+	s := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+	for i, part := range parts {
+		err := setValue(s.Index(i), strings.TrimSpace(part), sep)
+		if err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	v.Set(s)
+	return nil
+}
+
+func setMap(v reflect.Value, raw, sep string) error {
+	m := reflect.MakeMap(v.Type())
+	if raw != "" {
+		for entry := range strings.SplitSeq(raw, sep) {
+			k, val, ok := strings.Cut(entry, ":")
+			if !ok {
+				return fmt.Errorf("invalid map entry %q, expected key:value", entry)
+			}
+
+			key := reflect.New(v.Type().Key()).Elem()
+			err := setValue(key, strings.TrimSpace(k), sep)
+			if err != nil {
+				return fmt.Errorf("key %q: %w", k, err)
+			}
+
+			elem := reflect.New(v.Type().Elem()).Elem()
+			err = setValue(elem, strings.TrimSpace(val), sep)
+			if err != nil {
+				return fmt.Errorf("value of %q: %w", k, err)
+			}
+			m.SetMapIndex(key, elem)
+		}
+	}
+	v.Set(m)
+	return nil
+}
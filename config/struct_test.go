@@ -0,0 +1,303 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package config
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mapLookup looks up settings in a map instead of the environment.
+func mapLookup(m map[string]string) StructOption {
+	return Lookup(func(key string) Reader[string] {
+		v, ok := m[key]
+		if !ok {
+			return EmptyReader[string]()
+		}
+		return ReaderOf(v)
+	})
+}
+
+type dbConfig struct {
+	Host string `env:"HOST" default:"localhost"`
+	Port int    `env:"PORT" default:"5432"`
+}
+
+type appConfig struct {
+	Port     int               `env:"PORT" default:"8080"`
+	Timeout  time.Duration     `env:"TIMEOUT"`
+	Debug    bool              `env:"DEBUG"`
+	Ratio    float32           `env:"RATIO"`
+	Workers  uint8             `env:"WORKERS"`
+	Hosts    []string          `env:"HOSTS"`
+	Ports    []int             `env:"PORTS" sep:";"`
+	Labels   map[string]string `env:"LABELS"`
+	Addr     netip.Addr        `env:"ADDR"`
+	Name     *string           `env:"NAME"`
+	DB       dbConfig          `prefix:"DB_"`
+	Cache    *dbConfig         `prefix:"CACHE_"`
+	Ignored  string
+	internal string `env:"INTERNAL"`
+}
+
+func TestStruct(t *testing.T) {
+	name := "api"
+
+	testCases := []struct {
+		name     string
+		env      map[string]string
+		opts     []StructOption
+		expected appConfig
+	}{
+		{
+			name: "defaults",
+			env:  map[string]string{},
+			expected: appConfig{
+				Port: 8080,
+				DB:   dbConfig{Host: "localhost", Port: 5432},
+				Cache: &dbConfig{
+					Host: "localhost",
+					Port: 5432,
+				},
+			},
+		},
+		{
+			name: "all fields set",
+			env: map[string]string{
+				"PORT":       "9090",
+				"TIMEOUT":    "5s",
+				"DEBUG":      "true",
+				"RATIO":      "0.5",
+				"WORKERS":    "4",
+				"HOSTS":      "a, b,c",
+				"PORTS":      "1;2",
+				"LABELS":     "team:core,env:prod",
+				"ADDR":       "127.0.0.1",
+				"NAME":       "api",
+				"DB_HOST":    "db",
+				"DB_PORT":    "5433",
+				"CACHE_HOST": "cache",
+				"INTERNAL":   "secret",
+			},
+			expected: appConfig{
+				Port:    9090,
+				Timeout: 5 * time.Second,
+				Debug:   true,
+				Ratio:   0.5,
+				Workers: 4,
+				Hosts:   []string{"a", "b", "c"},
+				Ports:   []int{1, 2},
+				Labels:  map[string]string{"team": "core", "env": "prod"},
+				Addr:    netip.MustParseAddr("127.0.0.1"),
+				Name:    &name,
+				DB:      dbConfig{Host: "db", Port: 5433},
+				Cache:   &dbConfig{Host: "cache", Port: 5432},
+			},
+		},
+		{
+			name: "prefix",
+			env: map[string]string{
+				"APP_PORT":    "9090",
+				"APP_DB_HOST": "db",
+				"PORT":        "1",
+			},
+			opts: []StructOption{Prefix("APP_")},
+			expected: appConfig{
+				Port:  9090,
+				DB:    dbConfig{Host: "db", Port: 5432},
+				Cache: &dbConfig{Host: "localhost", Port: 5432},
+			},
+		},
+		{
+			name: "empty slice and map",
+			env: map[string]string{
+				"HOSTS":  "",
+				"LABELS": "",
+			},
+			expected: appConfig{
+				Port:   8080,
+				Hosts:  []string{},
+				Labels: map[string]string{},
+				DB:     dbConfig{Host: "localhost", Port: 5432},
+				Cache:  &dbConfig{Host: "localhost", Port: 5432},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := Struct[appConfig](append([]StructOption{mapLookup(tc.env)}, tc.opts...)...)
+
+			cfg, err := Read(context.Background(), r)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, cfg)
+		})
+	}
+
+	t.Run("reads environment variables by default", func(t *testing.T) {
+		t.Setenv("PORT", "9090")
+		t.Setenv("DB_HOST", "db")
+
+		cfg, err := Read(context.Background(), Struct[appConfig]())
+		require.NoError(t, err)
+		require.Equal(t, 9090, cfg.Port)
+		require.Equal(t, "db", cfg.DB.Host)
+	})
+
+	t.Run("nested struct pointer is nil when nothing is populated", func(t *testing.T) {
+		type config struct {
+			Port int `env:"PORT"`
+			DB   *struct {
+				Host string `env:"HOST"`
+			} `prefix:"DB_"`
+		}
+
+		cfg, err := Read(context.Background(), Struct[config](mapLookup(map[string]string{"PORT": "1"})))
+		require.NoError(t, err)
+		require.Equal(t, 1, cfg.Port)
+		require.Nil(t, cfg.DB)
+	})
+
+	t.Run("ignores pointers to a struct already being read", func(t *testing.T) {
+		type node struct {
+			Name string `env:"NAME"`
+			Next *node  `prefix:"NEXT_"`
+		}
+		type config struct {
+			Primary   *node `prefix:"PRIMARY_"`
+			Secondary *node `prefix:"SECONDARY_"`
+		}
+
+		cfg, err := Read(context.Background(), Struct[config](mapLookup(map[string]string{
+			"PRIMARY_NAME":      "a",
+			"PRIMARY_NEXT_NAME": "b",
+			"SECONDARY_NAME":    "c",
+		})))
+		require.NoError(t, err)
+		require.Equal(t, &node{Name: "a"}, cfg.Primary)
+		require.Equal(t, &node{Name: "c"}, cfg.Secondary)
+	})
+
+	t.Run("value is not set when nothing is populated", func(t *testing.T) {
+		type config struct {
+			Port int `env:"PORT"`
+		}
+
+		val, err := Struct[config](mapLookup(map[string]string{})).Read(context.Background())
+		require.NoError(t, err)
+
+		_, ok := val.Value()
+		require.False(t, ok)
+	})
+
+	t.Run("composes with Default and Map", func(t *testing.T) {
+		type config struct {
+			Port int `env:"PORT"`
+		}
+
+		r := Map(
+			Default(config{Port: 8080}, Struct[config](mapLookup(map[string]string{}))),
+			func(ctx context.Context, c config) (string, error) {
+				return ":" + strconv.Itoa(c.Port), nil
+			},
+		)
+
+		addr, err := Read(context.Background(), r)
+		require.NoError(t, err)
+		require.Equal(t, ":8080", addr)
+	})
+}
+
+func TestStruct_Errors(t *testing.T) {
+	t.Run("reports every missing and malformed field", func(t *testing.T) {
+		type config struct {
+			Port   int            `env:"PORT"`
+			Token  string         `env:"TOKEN" required:"true"`
+			Labels map[string]int `env:"LABELS"`
+			DB     struct {
+				Port uint16 `env:"PORT"`
+			} `prefix:"DB_"`
+		}
+
+		r := Struct[config](mapLookup(map[string]string{
+			"PORT":    "http",
+			"LABELS":  "a:1,b",
+			"DB_PORT": "70000",
+		}))
+
+		_, err := r.Read(context.Background())
+		require.Error(t, err)
+
+		var fieldErrs []*FieldError
+		for _, err := range err.(interface{ Unwrap() []error }).Unwrap() {
+			var fe *FieldError
+			require.ErrorAs(t, err, &fe)
+			fieldErrs = append(fieldErrs, fe)
+		}
+		require.Len(t, fieldErrs, 4)
+
+		require.Equal(t, "Port", fieldErrs[0].Field)
+		require.Equal(t, "PORT", fieldErrs[0].Key)
+		require.ErrorIs(t, fieldErrs[0], strconv.ErrSyntax)
+
+		require.Equal(t, "Token", fieldErrs[1].Field)
+		require.Equal(t, "TOKEN", fieldErrs[1].Key)
+		require.ErrorIs(t, fieldErrs[1], ErrValueNotSet)
+
+		require.Equal(t, "Labels", fieldErrs[2].Field)
+		require.Equal(t, `config: field Labels (LABELS): invalid map entry "b", expected key:value`, fieldErrs[2].Error())
+
+		require.Equal(t, "DB.Port", fieldErrs[3].Field)
+		require.Equal(t, "DB_PORT", fieldErrs[3].Key)
+		require.ErrorIs(t, fieldErrs[3], strconv.ErrRange)
+	})
+
+	t.Run("required field with a default is not an error", func(t *testing.T) {
+		type config struct {
+			Port int `env:"PORT" default:"8080" required:"true"`
+		}
+
+		cfg, err := Read(context.Background(), Struct[config](mapLookup(map[string]string{})))
+		require.NoError(t, err)
+		require.Equal(t, 8080, cfg.Port)
+	})
+
+	t.Run("lookup error", func(t *testing.T) {
+		type config struct {
+			Port int `env:"PORT"`
+		}
+
+		lookupErr := errors.New("unavailable")
+		r := Struct[config](Lookup(func(key string) Reader[string] {
+			return ReaderFunc[string](func(ctx context.Context) (Value[string], error) {
+				return Value[string]{}, lookupErr
+			})
+		}))
+
+		_, err := r.Read(context.Background())
+		require.ErrorIs(t, err, lookupErr)
+	})
+
+	t.Run("unsupported field type", func(t *testing.T) {
+		type config struct {
+			Ch chan int `env:"CH"`
+		}
+
+		_, err := Struct[config](mapLookup(map[string]string{"CH": "1"})).Read(context.Background())
+		require.EqualError(t, err, "config: field Ch (CH): unsupported type chan int")
+	})
+
+	t.Run("non-struct type", func(t *testing.T) {
+		_, err := Struct[int]().Read(context.Background())
+		require.EqualError(t, err, "config: Struct requires a struct type, got int")
+	})
+}
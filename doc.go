@@ -17,9 +17,162 @@
 //
 //   - Map: Transform builder outputs using pure functions
 //   - Bind: Chain builders together, allowing the output of one to inform the construction of the next
+//   - Switch: Build one of several builders, chosen by a configuration setting
 //
 // These combinators allow you to build complex applications from simple, reusable components.
 //
+// # Resource Cleanup
+//
+// Builders which open resources like listeners, connections or files can register a
+// release function with OnCleanup, or wrap an existing Builder with BuildWithCleanup:
+//
+//	db := bedrock.BuildWithCleanup(dbBuilder, func(ctx context.Context, db *sql.DB) error {
+//	    return db.Close()
+//	})
+//
+// DefaultRunner runs the registered release functions in reverse construction order
+// when a later builder fails or once the Runtime returns.
+//
+// # Build Errors
+//
+// Builders report which component failed, and which configuration setting caused the
+// failure, by returning a *BuildError. ConfigError records the setting and WrapBuildError
+// prepends the name of each enclosing component, so a missing setting deep inside an
+// application is reported as:
+//
+//	build otel.Runtime > TracerProvider > TraceIDRatioBasedSampler: config "ratio": config: value not set
+//
+// If the setting is read from a single source, such as an environment variable, the
+// source is reported as well, e.g. config "ratio" (env TRACE_SAMPLE_RATIO).
+//
+// # Checking Configuration
+//
+// DryRunner builds the application in dry run mode to validate its configuration
+// without running it. Settings read with ReadConfig are all read, with every missing or
+// malformed one reported together, and builders which bind ports or dial remote services
+// check DryRun to skip creating them:
+//
+//	runner := bedrock.DefaultRunner[bedrock.Runtime]()
+//	if bedrock.CheckConfigRequested(os.Args[1:]) {
+//	    runner = bedrock.DryRunner[bedrock.Runtime]()
+//	}
+//
+// # Concurrent Construction
+//
+// All and Zip2 through Zip6 build independent Builders concurrently. The first failure
+// cancels the remaining Builders and the errors of every failed Builder are returned:
+//
+//	app := bedrock.Zip2(dbBuilder, cacheBuilder, func(ctx context.Context, db *sql.DB, c *Cache) (App, error) {
+//	    return App{db: db, cache: c}, nil
+//	})
+//
+// # Build Graph
+//
+// Named gives a Builder a component name. Building with a context returned by
+// WithBuildGraph records every named component, how long it took to build, any error
+// it returned and which components it depends on. The recorded graph can be rendered
+// as JSON or Graphviz DOT:
+//
+//	ctx, graph := bedrock.WithBuildGraph(ctx)
+//	err := runner.Run(ctx, runtime)
+//	graph.WriteDOT(os.Stdout)
+//
+// The builders provided by runtime/http and runtime/otel are already named.
+//
+// ReportStartup records the graph for every run and hands it over once the application
+// has been built, e.g. to print how long each component took with WriteReport. The
+// report can also be served by the runtime/http BuildReportHandler, and runtime/otel
+// traces each build as a span once its TracerProvider is registered.
+//
+// # Running Multiple Runtimes
+//
+// Group combines several Runtimes, such as an API server, an admin server and a
+// background worker, into a single Runtime. A failing member stops the rest of the
+// Group and is identified by a *MemberError:
+//
+//	rt := bedrock.Group(
+//	    bedrock.Member("api", api),
+//	    bedrock.Member("admin", admin),
+//	    bedrock.Member("migrate", migrate, bedrock.AllowExit()),
+//	)
+//
+// Sequence instead runs Runtimes one after another, such as migrations and cache warmup
+// before the server starts. Each phase may have its own timeout, and phases marked with
+// PostStop run once the others have finished, even if one of them failed:
+//
+//	rt := bedrock.Sequence(
+//	    bedrock.Phase("migrate", migrate, bedrock.PhaseTimeout(5*time.Minute)),
+//	    bedrock.Phase("serve", api),
+//	    bedrock.Phase("deregister", deregister, bedrock.PostStop()),
+//	)
+//
+// # Retrying Builders
+//
+// Retry rebuilds a component whose dependencies may not be reachable yet, e.g. while
+// a database is starting. The RetryPolicy settings are config.Readers, so they can be
+// tuned through configuration:
+//
+//	db := bedrock.Retry(dbBuilder, bedrock.RetryPolicy{
+//	    MaxAttempts:    config.IntFromString(config.Env("DB_CONNECT_ATTEMPTS")),
+//	    AttemptTimeout: config.ReaderOf(5 * time.Second),
+//	})
+//
+// # Restarting Failed Runtimes
+//
+// Supervise wraps a Runner so a failed application is rebuilt and restarted according
+// to a RestartPolicy, giving up with ErrRestartLimitExceeded once it fails too often:
+//
+//	runner := bedrock.Supervise(bedrock.DefaultRunner[bedrock.Runtime](), bedrock.RestartPolicy{
+//	    MaxRestarts: 5,
+//	    Window:      time.Minute,
+//	    Backoff:     bedrock.ExponentialBackoff(100*time.Millisecond, 10*time.Second),
+//	})
+//
+// Supervising a Group restarts every member (one-for-all), while RestartMember only
+// restarts the member which failed (one-for-one).
+//
+// # Graceful Shutdown
+//
+// GracefulShutdown bounds how long an application may take to shut down once a signal
+// is received, returning ErrShutdownTimeout when the deadline passes and
+// ErrShutdownAborted on a second signal. Runtimes use ShutdownContext for their own
+// cleanup so it fits within the same deadline:
+//
+//	<-ctx.Done()
+//	shutdownCtx, cancel := bedrock.ShutdownContext(ctx)
+//	defer cancel()
+//	return srv.Shutdown(shutdownCtx)
+//
+// # Readiness
+//
+// A Runtime calls Ready once it has started and can do work, e.g. once an HTTP server is
+// accepting connections. Whoever runs it can wait for that with NotifyReady. A Group is
+// ready once all of its members are. The health package builds readiness probes on this,
+// and the systemd package reports it to systemd for services with Type=notify.
+//
+// # Lifecycle Events
+//
+// Observe wraps a Runner to publish each transition of the application, from building
+// through running and draining to stopped or failed, to a set of Observers. Components
+// can register their own Observer while being built with OnLifecycle:
+//
+//	runner := bedrock.Observe(bedrock.DefaultRunner[bedrock.Runtime](), bedrock.ObserverFunc(func(ctx context.Context, e bedrock.LifecycleEvent) {
+//	    slog.InfoContext(ctx, "lifecycle", slog.String("state", e.State.String()))
+//	}))
+//
+// # Hot Reload
+//
+// HotReload wraps a Runner so the application is rebuilt on SIGHUP, or a change
+// notification, without restarting the process. The new instance is started and
+// the old one is only drained once the new one is Ready; if the rebuild fails the
+// old instance keeps running:
+//
+//	runner := bedrock.HotReload(bedrock.DefaultRunner[bedrock.Runtime](),
+//	    bedrock.ReloadOnSignal(syscall.SIGHUP),
+//	)
+//
+// Carry shares a component, such as a listening socket, between instances.
+//
 // # Basic Usage
 //
 // Create a builder for your application component:
@@ -36,7 +189,14 @@
 //	    }), nil
 //	})
 //
-// Run the application with signal handling and panic recovery:
+// Run the application with Main, which handles SIGINT and SIGTERM, recovers panics,
+// supports --check-config and exits with a code describing the outcome:
+//
+//	func main() {
+//	    bedrock.Main(runtime)
+//	}
+//
+// Runners can also be composed by hand for more control:
 //
 //	runner := bedrock.RecoverPanics(
 //	    bedrock.NotifyOnSignal(
@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/z5labs/bedrock/config"
+)
+
+// dryRunState collects the configuration errors recorded while checking
+// the configuration of an application.
+type dryRunState struct {
+	mu   sync.Mutex
+	errs []error
+}
+
+func (s *dryRunState) record(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.errs = append(s.errs, err)
+}
+
+// join returns the recorded errors joined with err.
+func (s *dryRunState) join(err error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return errors.Join(append(slices.Clone(s.errs), err)...)
+}
+
+type dryRunKey struct{}
+
+// buildPathKey holds the names of the [Named] components being built.
+type buildPathKey struct{}
+
+// DryRun reports whether ctx is used to check the configuration of the application,
+// see [DryRunner], rather than to build it. Builders which create side-effecting
+// resources, such as binding a port or dialing a remote service, should read their
+// configuration as usual but skip creating the resource when DryRun returns true.
+func DryRun(ctx context.Context) bool {
+	_, ok := ctx.Value(dryRunKey{}).(*dryRunState)
+	return ok
+}
+
+// ReadConfig reads the configuration setting named key from r. If the setting cannot
+// be read, or is not set, the error is returned wrapped with [ConfigError].
+//
+// In dry run mode, see [DryRun], the error is instead recorded, along with the names
+// of the [Named] components being built, and the zero value is returned without an
+// error so that the remaining settings are read as well.
+//
+// If ctx carries a config.Report, see config.WithReport, the value is recorded in it
+// along with where it was read from, under key qualified by the names of the [Named]
+// components being built, e.g. "http.Runtime/TCPListener.addr".
+func ReadConfig[T any](ctx context.Context, key string, r config.Reader[T]) (T, error) {
+	val, _, err := readConfig(ctx, key, r)
+	v, _ := val.Value()
+	return v, err
+}
+
+// readConfig is like ReadConfig but returns the Value read, so its source is known,
+// and also reports whether the setting was read, which is not the case when its
+// error has been recorded in dry run mode.
+func readConfig[T any](ctx context.Context, key string, r config.Reader[T]) (config.Value[T], bool, error) {
+	if path, _ := ctx.Value(buildPathKey{}).([]string); len(path) > 0 {
+		ctx = config.WithReportPrefix(ctx, strings.Join(path, "/"))
+	}
+
+	val, err := r.Read(ctx)
+	if err != nil {
+		return config.Value[T]{}, false, configError(ctx, key, err)
+	}
+
+	config.Record(ctx, key, val)
+	if _, ok := val.Value(); !ok {
+		return val, false, configError(ctx, key, withSource(val.Source(), config.ErrValueNotSet))
+	}
+	return val, true, nil
+}
+
+// withSource wraps err in a *config.SourceError, unless src is unknown.
+func withSource(src config.Source, err error) error {
+	if src.Kind == config.SourceUnknown {
+		return err
+	}
+	return &config.SourceError{Source: src, Err: err}
+}
+
+// configError wraps err with [ConfigError] or, in dry run mode, records it
+// and returns nil.
+func configError(ctx context.Context, key string, err error) error {
+	state, ok := ctx.Value(dryRunKey{}).(*dryRunState)
+	if !ok {
+		return ConfigError(key, err)
+	}
+
+	be := ConfigError(key, err).(*BuildError)
+	path, _ := ctx.Value(buildPathKey{}).([]string)
+	be.Path = slices.Clone(path)
+	state.record(be)
+	return nil
+}
+
+// DryRunner returns a Runner which checks the configuration of the application
+// instead of running it, e.g. to validate the environment of a deployment before
+// rolling it out.
+//
+// The application is built in dry run mode, see [DryRun], so every setting read with
+// [ReadConfig] is read and no side-effecting resources are created. Every configuration
+// error, along with any other error returned by the build, is returned joined together.
+// The Runtime is never run and release functions registered with [OnCleanup] are run
+// once the build returns.
+func DryRunner[T Runtime]() Runner[T] {
+	return RunnerFunc[T](func(ctx context.Context, builder Builder[T]) (err error) {
+		state := &dryRunState{}
+		ctx, release := WithCleanup(context.WithValue(ctx, dryRunKey{}, state))
+		defer func() {
+			if rerr := release(context.WithoutCancel(ctx)); rerr != nil {
+				err = errors.Join(err, rerr)
+			}
+		}()
+
+		_, err = builder.Build(ctx)
+		return state.join(err)
+	})
+}
+
+// CheckConfigRequested reports whether args, typically os.Args[1:], contain the
+// --check-config flag which selects [DryRunner] over running the application.
+// As with the flag package, the flag may be given a value, e.g. --check-config=false,
+// in which case the last occurrence wins. Arguments after a "--" terminator are ignored.
+func CheckConfigRequested(args []string) bool {
+	requested := false
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(strings.TrimPrefix(arg, "-"), "-"), "=")
+		if name != "check-config" || name == arg {
+			continue
+		}
+		if !hasValue {
+			requested = true
+			continue
+		}
+		b, err := strconv.ParseBool(value)
+		requested = err == nil && b
+	}
+	return requested
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/z5labs/bedrock/config"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadConfig(t *testing.T) {
+	t.Run("returns the value", func(t *testing.T) {
+		v, err := ReadConfig(context.Background(), "port", config.ReaderOf(8080))
+		require.NoError(t, err)
+		require.Equal(t, 8080, v)
+	})
+
+	t.Run("returns a config error when the value is not set", func(t *testing.T) {
+		_, err := ReadConfig(context.Background(), "port", config.EmptyReader[int]())
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, "port", buildErr.Key)
+	})
+
+	t.Run("records the source of a setting which is not set", func(t *testing.T) {
+		_, err := ReadConfig(context.Background(), "port", config.IntFromString(config.Env("TEST_READ_CONFIG_UNSET")))
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, config.Source{Kind: config.SourceEnv, Name: "TEST_READ_CONFIG_UNSET"}, buildErr.Source)
+		require.Equal(t, `build: config "port" (env TEST_READ_CONFIG_UNSET): config: value not set`, err.Error())
+	})
+
+	t.Run("records the source of a setting which cannot be parsed", func(t *testing.T) {
+		t.Setenv("TEST_READ_CONFIG_PORT", "http")
+
+		_, err := ReadConfig(context.Background(), "port", config.IntFromString(config.Env("TEST_READ_CONFIG_PORT")))
+		require.Error(t, err)
+
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, config.Source{Kind: config.SourceEnv, Name: "TEST_READ_CONFIG_PORT"}, buildErr.Source)
+	})
+
+	t.Run("records the value in the config report", func(t *testing.T) {
+		t.Setenv("TEST_READ_CONFIG_PORT", "9090")
+
+		ctx, report := config.WithReport(context.Background())
+		_, err := ReadConfig(ctx, "port", config.Default(8080, config.IntFromString(config.Env("TEST_READ_CONFIG_PORT"))))
+		require.NoError(t, err)
+		_, err = ReadConfig(ctx, "timeout", config.Default(30, config.IntFromString(config.Env("TEST_READ_CONFIG_TIMEOUT"))))
+		require.NoError(t, err)
+
+		require.Equal(t, []config.ReportEntry{
+			{Key: "port", Value: "9090", Source: config.Source{Kind: config.SourceEnv, Name: "TEST_READ_CONFIG_PORT"}},
+			{Key: "timeout", Value: "30", Source: config.Source{Kind: config.SourceDefault}},
+		}, report.Entries())
+	})
+
+	t.Run("qualifies the recorded key with the names of the components being built", func(t *testing.T) {
+		readAddr := func(addr string) Builder[string] {
+			return BuilderFunc[string](func(ctx context.Context) (string, error) {
+				return ReadConfig(ctx, "addr", config.Default(addr, config.Env("TEST_READ_CONFIG_ADDR")))
+			})
+		}
+
+		ctx, report := config.WithReport(context.Background())
+		_, err := Named("Public", Named("TCPListener", readAddr(":8080"))).Build(ctx)
+		require.NoError(t, err)
+		_, err = Named("Admin", Named("TCPListener", readAddr(":9090"))).Build(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, []config.ReportEntry{
+			{Key: "Public/TCPListener.addr", Value: ":8080", Source: config.Source{Kind: config.SourceDefault}},
+			{Key: "Admin/TCPListener.addr", Value: ":9090", Source: config.Source{Kind: config.SourceDefault}},
+		}, report.Entries())
+	})
+}
+
+func TestDryRunner(t *testing.T) {
+	t.Run("collects every config error", func(t *testing.T) {
+		parseErr := errors.New("invalid syntax")
+
+		db := Named("db", BuilderFunc[string](func(ctx context.Context) (string, error) {
+			return ReadConfig(ctx, "dsn", config.EmptyReader[string]())
+		}))
+		api := Named("api", Bind(db, func(ctx context.Context, db string) Builder[Runtime] {
+			return BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+				_, err := ReadConfig(ctx, "port", config.ReaderFunc[int](func(ctx context.Context) (config.Value[int], error) {
+					return config.Value[int]{}, parseErr
+				}))
+				if err != nil {
+					return nil, err
+				}
+				return RuntimeFunc(func(ctx context.Context) error {
+					t.Fatal("runtime should not be run")
+					return nil
+				}), nil
+			})
+		}))
+
+		err := DryRunner[Runtime]().Run(context.Background(), api)
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+		require.ErrorIs(t, err, parseErr)
+
+		joined, ok := err.(interface{ Unwrap() []error })
+		require.True(t, ok)
+
+		var keys []string
+		var paths [][]string
+		for _, e := range joined.Unwrap() {
+			var buildErr *BuildError
+			require.ErrorAs(t, e, &buildErr)
+			keys = append(keys, buildErr.Key)
+			paths = append(paths, buildErr.Path)
+		}
+		require.Equal(t, []string{"dsn", "port"}, keys)
+		require.Equal(t, [][]string{{"api", "db"}, {"api"}}, paths)
+	})
+
+	t.Run("returns nil when the configuration is complete", func(t *testing.T) {
+		builder := BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			require.True(t, DryRun(ctx))
+
+			_, err := ReadConfig(ctx, "port", config.ReaderOf(8080))
+			return RuntimeFunc(func(ctx context.Context) error { return nil }), err
+		})
+
+		err := DryRunner[Runtime]().Run(context.Background(), builder)
+		require.NoError(t, err)
+	})
+
+	t.Run("returns build errors which are not caused by configuration", func(t *testing.T) {
+		buildErr := errors.New("failed")
+
+		err := DryRunner[Runtime]().Run(context.Background(), BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			return nil, buildErr
+		}))
+		require.ErrorIs(t, err, buildErr)
+	})
+
+	t.Run("releases resources once built", func(t *testing.T) {
+		var released atomic.Bool
+		builder := BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			OnCleanup(ctx, func(ctx context.Context) error {
+				released.Store(true)
+				return nil
+			})
+			return RuntimeFunc(func(ctx context.Context) error { return nil }), nil
+		})
+
+		err := DryRunner[Runtime]().Run(context.Background(), builder)
+		require.NoError(t, err)
+		require.True(t, released.Load())
+	})
+
+	t.Run("builds retried builders only once", func(t *testing.T) {
+		var attempts atomic.Int64
+		builder := Retry(BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			attempts.Add(1)
+			return nil, errors.New("unavailable")
+		}), RetryPolicy{})
+
+		err := DryRunner[Runtime]().Run(context.Background(), builder)
+		require.Error(t, err)
+		require.Equal(t, int64(1), attempts.Load())
+	})
+}
+
+func TestDryRun(t *testing.T) {
+	require.False(t, DryRun(context.Background()))
+}
+
+func TestCheckConfigRequested(t *testing.T) {
+	testCases := []struct {
+		name string
+		args []string
+		want bool
+	}{
+		{name: "no args", args: nil, want: false},
+		{name: "double dash flag", args: []string{"--check-config"}, want: true},
+		{name: "single dash flag", args: []string{"-v", "-check-config"}, want: true},
+		{name: "after terminator", args: []string{"--", "--check-config"}, want: false},
+		{name: "other flags", args: []string{"--port", "8080"}, want: false},
+		{name: "double dash flag set to true", args: []string{"--check-config=true"}, want: true},
+		{name: "single dash flag set to true", args: []string{"-check-config=true"}, want: true},
+		{name: "flag set to 1", args: []string{"--check-config=1"}, want: true},
+		{name: "flag set to false", args: []string{"--check-config=false"}, want: false},
+		{name: "flag set to an invalid value", args: []string{"--check-config=yes"}, want: false},
+		{name: "last flag wins", args: []string{"--check-config", "--check-config=false"}, want: false},
+		{name: "without dashes", args: []string{"check-config"}, want: false},
+		{name: "flag with a similar name", args: []string{"--check-config-file=app.yaml"}, want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, CheckConfigRequested(tc.args))
+		})
+	}
+}
@@ -6,29 +6,13 @@
 package main
 
 import (
-	"context"
-	"log"
-	"os"
-
 	"github.com/z5labs/bedrock"
 	"github.com/z5labs/bedrock/example/http/rest/ecommerce/app"
 	"github.com/z5labs/bedrock/example/http/rest/ecommerce/services/cart"
-	httprt "github.com/z5labs/bedrock/runtime/http"
 )
 
 func main() {
 	cartSvc := cart.NewService()
 
-	rt := app.New(cartSvc)
-
-	runner := bedrock.NotifyOnSignal(
-		bedrock.RecoverPanics(
-			bedrock.DefaultRunner[httprt.Runtime](),
-		),
-		os.Interrupt,
-	)
-
-	if err := runner.Run(context.Background(), rt); err != nil {
-		log.Fatal(err)
-	}
+	bedrock.Main(app.New(cartSvc))
 }
@@ -0,0 +1,303 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// GraphNode describes a named component recorded in a [BuildGraph].
+type GraphNode struct {
+	// Name is the name given to the component with [Named].
+	Name string
+
+	// Builds is the number of times the component was built. A component
+	// which is expected to be shared, but is built more than once, is
+	// usually missing a [MemoizeBuilder].
+	Builds int
+
+	// Duration is the total time spent building the component, including
+	// the time spent building its dependencies.
+	Duration time.Duration
+
+	// Err is the error returned by the most recent build of the component, if any.
+	Err error
+}
+
+// GraphEdge records that the component named From depends on the component named To.
+type GraphEdge struct {
+	From string
+	To   string
+}
+
+// BuildRecord describes a single build of a named component recorded in a [BuildGraph].
+type BuildRecord struct {
+	// ID identifies the build within the graph, starting at 1.
+	ID int
+
+	// Parent is the ID of the build of the named component which depends on
+	// this one, or 0 if the component was built directly.
+	Parent int
+
+	// Name is the name given to the component with [Named].
+	Name string
+
+	// Start is when the build started.
+	Start time.Time
+
+	// Duration is how long the build took, including the time spent building
+	// its dependencies. It is zero while the build is still in progress.
+	Duration time.Duration
+
+	// Err is the error returned by the build, if any.
+	Err error
+}
+
+// BuildGraph records the named components built with a context returned
+// by [WithBuildGraph] and the dependencies between them.
+//
+// A BuildGraph is safe for concurrent use.
+type BuildGraph struct {
+	mu    sync.Mutex
+	index map[string]int
+	nodes []GraphNode
+	seen  map[GraphEdge]struct{}
+	edges []GraphEdge
+
+	records []BuildRecord
+}
+
+// graphParent identifies the build of the named component currently being built.
+type graphParent struct {
+	name string
+	id   int
+}
+
+func (g *BuildGraph) start(name string, parent graphParent, start time.Time) int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if _, ok := g.index[name]; !ok {
+		g.index[name] = len(g.nodes)
+		g.nodes = append(g.nodes, GraphNode{Name: name})
+	}
+
+	id := len(g.records) + 1
+	g.records = append(g.records, BuildRecord{
+		ID:     id,
+		Parent: parent.id,
+		Name:   name,
+		Start:  start,
+	})
+
+	if parent.name == "" || parent.name == name {
+		return id
+	}
+	edge := GraphEdge{From: parent.name, To: name}
+	if _, ok := g.seen[edge]; ok {
+		return id
+	}
+	g.seen[edge] = struct{}{}
+	g.edges = append(g.edges, edge)
+	return id
+}
+
+func (g *BuildGraph) finish(name string, id int, d time.Duration, err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	n := &g.nodes[g.index[name]]
+	n.Builds++
+	n.Duration += d
+	n.Err = err
+
+	r := &g.records[id-1]
+	r.Duration = d
+	r.Err = err
+}
+
+// Nodes returns the recorded components in the order they started building.
+func (g *BuildGraph) Nodes() []GraphNode {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	nodes := make([]GraphNode, len(g.nodes))
+	copy(nodes, g.nodes)
+	return nodes
+}
+
+// Edges returns the recorded dependencies in the order they were first built.
+func (g *BuildGraph) Edges() []GraphEdge {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	edges := make([]GraphEdge, len(g.edges))
+	copy(edges, g.edges)
+	return edges
+}
+
+// Records returns every recorded build in the order they started. Unlike [BuildGraph.Nodes],
+// a component which was built more than once has a record for each build.
+func (g *BuildGraph) Records() []BuildRecord {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	records := make([]BuildRecord, len(g.records))
+	copy(records, g.records)
+	return records
+}
+
+type jsonGraphNode struct {
+	Name     string `json:"name"`
+	Builds   int    `json:"builds"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+type jsonGraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+type jsonBuildRecord struct {
+	ID       int    `json:"id"`
+	Parent   int    `json:"parent,omitempty"`
+	Name     string `json:"name"`
+	Start    string `json:"start"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+type jsonGraph struct {
+	Nodes  []jsonGraphNode   `json:"nodes"`
+	Edges  []jsonGraphEdge   `json:"edges"`
+	Builds []jsonBuildRecord `json:"builds"`
+}
+
+// MarshalJSON implements the [json.Marshaler] interface.
+func (g *BuildGraph) MarshalJSON() ([]byte, error) {
+	nodes := g.Nodes()
+	edges := g.Edges()
+	records := g.Records()
+
+	jg := jsonGraph{
+		Nodes:  make([]jsonGraphNode, 0, len(nodes)),
+		Edges:  make([]jsonGraphEdge, 0, len(edges)),
+		Builds: make([]jsonBuildRecord, 0, len(records)),
+	}
+	for _, n := range nodes {
+		jn := jsonGraphNode{
+			Name:     n.Name,
+			Builds:   n.Builds,
+			Duration: n.Duration.String(),
+		}
+		if n.Err != nil {
+			jn.Error = n.Err.Error()
+		}
+		jg.Nodes = append(jg.Nodes, jn)
+	}
+	for _, e := range edges {
+		jg.Edges = append(jg.Edges, jsonGraphEdge(e))
+	}
+	for _, r := range records {
+		jr := jsonBuildRecord{
+			ID:       r.ID,
+			Parent:   r.Parent,
+			Name:     r.Name,
+			Start:    r.Start.Format(time.RFC3339Nano),
+			Duration: r.Duration.String(),
+		}
+		if r.Err != nil {
+			jr.Error = r.Err.Error()
+		}
+		jg.Builds = append(jg.Builds, jr)
+	}
+	return json.Marshal(jg)
+}
+
+// WriteDOT writes the graph to w in the Graphviz DOT language. Each node is
+// labelled with its build duration and components which failed to build are
+// drawn in red.
+func (g *BuildGraph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("digraph bedrock {\n")
+	for _, n := range g.Nodes() {
+		label := n.Name + "\n" + n.Duration.String()
+		if n.Builds > 1 {
+			label += " (" + strconv.Itoa(n.Builds) + " builds)"
+		}
+
+		bw.WriteString("\t" + strconv.Quote(n.Name) + " [label=" + strconv.Quote(label))
+		if n.Err != nil {
+			bw.WriteString(", color=red")
+		}
+		bw.WriteString("];\n")
+	}
+	for _, e := range g.Edges() {
+		bw.WriteString("\t" + strconv.Quote(e.From) + " -> " + strconv.Quote(e.To) + ";\n")
+	}
+	bw.WriteString("}\n")
+	return bw.Flush()
+}
+
+type buildGraphKey struct{}
+
+type graphParentKey struct{}
+
+// BuildGraphFromContext returns the BuildGraph recording the components built
+// with ctx, if ctx was derived from one returned by [WithBuildGraph].
+func BuildGraphFromContext(ctx context.Context) (*BuildGraph, bool) {
+	g, ok := ctx.Value(buildGraphKey{}).(*BuildGraph)
+	return g, ok
+}
+
+// WithBuildGraph returns a copy of ctx which records every component built by
+// a [Named] Builder, along with the BuildGraph they are recorded in.
+func WithBuildGraph(ctx context.Context) (context.Context, *BuildGraph) {
+	g := &BuildGraph{
+		index: make(map[string]int),
+		seen:  make(map[GraphEdge]struct{}),
+	}
+	return context.WithValue(ctx, buildGraphKey{}, g), g
+}
+
+// Named gives the component built by builder a name. If the context passed to
+// Build was derived from one returned by [WithBuildGraph], the component is
+// recorded in the graph along with the named components it depends on. The name
+// is also included in the path of any configuration error recorded by [ReadConfig]
+// in dry run mode, see [DryRun], and in the keys of the values it records in a
+// config.Report. Otherwise, Named has no effect.
+//
+// Wrapping a [MemoizeBuilder] with Named records every component depending on
+// it, while wrapping the Builder passed to MemoizeBuilder records how many
+// times it was actually built.
+func Named[T any](name string, builder Builder[T]) Builder[T] {
+	return BuilderFunc[T](func(ctx context.Context) (T, error) {
+		path, _ := ctx.Value(buildPathKey{}).([]string)
+		ctx = context.WithValue(ctx, buildPathKey{}, append(slices.Clip(path), name))
+
+		g, ok := ctx.Value(buildGraphKey{}).(*BuildGraph)
+		if !ok {
+			return builder.Build(ctx)
+		}
+
+		parent, _ := ctx.Value(graphParentKey{}).(graphParent)
+		start := time.Now()
+		id := g.start(name, parent, start)
+
+		value, err := builder.Build(context.WithValue(ctx, graphParentKey{}, graphParent{name: name, id: id}))
+		g.finish(name, id, time.Since(start), err)
+
+		return value, err
+	})
+}
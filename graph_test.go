@@ -0,0 +1,247 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func graphNames(nodes []GraphNode) []string {
+	names := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		names = append(names, n.Name)
+	}
+	return names
+}
+
+func TestNamed(t *testing.T) {
+	t.Run("has no effect without a build graph", func(t *testing.T) {
+		v, err := Named("value", BuilderOf(1)).Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, v)
+	})
+
+	t.Run("records nodes and edges", func(t *testing.T) {
+		db := Named("db", BuilderOf("db"))
+		cache := Named("cache", BuilderOf("cache"))
+		app := Named("app", Bind(db, func(ctx context.Context, db string) Builder[string] {
+			return Map(cache, func(ctx context.Context, cache string) (string, error) {
+				return db + "+" + cache, nil
+			})
+		}))
+
+		ctx, g := WithBuildGraph(context.Background())
+		v, err := app.Build(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "db+cache", v)
+
+		require.Equal(t, []string{"app", "db", "cache"}, graphNames(g.Nodes()))
+		require.Equal(t, []GraphEdge{
+			{From: "app", To: "db"},
+			{From: "app", To: "cache"},
+		}, g.Edges())
+
+		for _, n := range g.Nodes() {
+			require.Equal(t, 1, n.Builds)
+			require.NoError(t, n.Err)
+		}
+	})
+
+	t.Run("records builds from concurrent dependents", func(t *testing.T) {
+		db := Named("db", BuilderOf("db"))
+		users := Named("users", Map(db, func(ctx context.Context, db string) (string, error) {
+			return db, nil
+		}))
+		orders := Named("orders", Map(db, func(ctx context.Context, db string) (string, error) {
+			return db, nil
+		}))
+		app := Named("app", All(users, orders))
+
+		ctx, g := WithBuildGraph(context.Background())
+		_, err := app.Build(ctx)
+		require.NoError(t, err)
+
+		require.ElementsMatch(t, []GraphEdge{
+			{From: "app", To: "users"},
+			{From: "app", To: "orders"},
+			{From: "users", To: "db"},
+			{From: "orders", To: "db"},
+		}, g.Edges())
+
+		for _, n := range g.Nodes() {
+			if n.Name == "db" {
+				require.Equal(t, 2, n.Builds)
+			}
+		}
+	})
+
+	t.Run("records builds of memoized builders only once", func(t *testing.T) {
+		db := MemoizeBuilder(Named("db", BuilderOf("db")))
+		app := Named("app", All(db, db, db))
+
+		ctx, g := WithBuildGraph(context.Background())
+		_, err := app.Build(ctx)
+		require.NoError(t, err)
+
+		nodes := g.Nodes()
+		require.Equal(t, []string{"app", "db"}, graphNames(nodes))
+		require.Equal(t, 1, nodes[1].Builds)
+	})
+
+	t.Run("records errors", func(t *testing.T) {
+		buildErr := errors.New("dial failed")
+		db := Named("db", BuilderFunc[string](func(ctx context.Context) (string, error) {
+			return "", buildErr
+		}))
+
+		ctx, g := WithBuildGraph(context.Background())
+		_, err := Named("app", db).Build(ctx)
+		require.Equal(t, buildErr, err)
+
+		for _, n := range g.Nodes() {
+			require.Equal(t, buildErr, n.Err)
+		}
+	})
+}
+
+func TestBuildGraph_Records(t *testing.T) {
+	t.Run("records every build with its parent", func(t *testing.T) {
+		db := Named("db", BuilderOf("db"))
+		users := Named("users", Map(db, func(ctx context.Context, db string) (string, error) {
+			return db, nil
+		}))
+		app := Named("app", Bind(users, func(ctx context.Context, users string) Builder[string] {
+			return db
+		}))
+
+		ctx, g := WithBuildGraph(context.Background())
+		_, err := app.Build(ctx)
+		require.NoError(t, err)
+
+		records := g.Records()
+		require.Len(t, records, 4)
+
+		type build struct {
+			ID     int
+			Parent int
+			Name   string
+		}
+		var builds []build
+		for _, r := range records {
+			builds = append(builds, build{ID: r.ID, Parent: r.Parent, Name: r.Name})
+			require.False(t, r.Start.IsZero())
+			require.NoError(t, r.Err)
+		}
+		require.Equal(t, []build{
+			{ID: 1, Parent: 0, Name: "app"},
+			{ID: 2, Parent: 1, Name: "users"},
+			{ID: 3, Parent: 2, Name: "db"},
+			{ID: 4, Parent: 1, Name: "db"},
+		}, builds)
+	})
+
+	t.Run("records the error of each build", func(t *testing.T) {
+		buildErr := errors.New("dial failed")
+		db := Named("db", BuilderFunc[string](func(ctx context.Context) (string, error) {
+			return "", buildErr
+		}))
+
+		ctx, g := WithBuildGraph(context.Background())
+		_, err := db.Build(ctx)
+		require.Equal(t, buildErr, err)
+
+		records := g.Records()
+		require.Len(t, records, 1)
+		require.Equal(t, buildErr, records[0].Err)
+	})
+}
+
+func TestBuildGraphFromContext(t *testing.T) {
+	_, ok := BuildGraphFromContext(context.Background())
+	require.False(t, ok)
+
+	ctx, g := WithBuildGraph(context.Background())
+	got, ok := BuildGraphFromContext(ctx)
+	require.True(t, ok)
+	require.Same(t, g, got)
+}
+
+func TestBuildGraph_MarshalJSON(t *testing.T) {
+	ctx, g := WithBuildGraph(context.Background())
+	db := Named("db", BuilderFunc[string](func(ctx context.Context) (string, error) {
+		return "", errors.New("dial failed")
+	}))
+	_, err := Named("app", db).Build(ctx)
+	require.Error(t, err)
+
+	b, err := json.Marshal(g)
+	require.NoError(t, err)
+
+	var out struct {
+		Nodes []struct {
+			Name     string `json:"name"`
+			Builds   int    `json:"builds"`
+			Duration string `json:"duration"`
+			Error    string `json:"error"`
+		} `json:"nodes"`
+		Edges []struct {
+			From string `json:"from"`
+			To   string `json:"to"`
+		} `json:"edges"`
+		Builds []struct {
+			ID       int    `json:"id"`
+			Parent   int    `json:"parent"`
+			Name     string `json:"name"`
+			Start    string `json:"start"`
+			Duration string `json:"duration"`
+			Error    string `json:"error"`
+		} `json:"builds"`
+	}
+	require.NoError(t, json.Unmarshal(b, &out))
+
+	require.Len(t, out.Nodes, 2)
+	require.Equal(t, "app", out.Nodes[0].Name)
+	require.Equal(t, 1, out.Nodes[0].Builds)
+	require.NotEmpty(t, out.Nodes[0].Duration)
+	require.Equal(t, "dial failed", out.Nodes[1].Error)
+
+	require.Len(t, out.Edges, 1)
+	require.Equal(t, "app", out.Edges[0].From)
+	require.Equal(t, "db", out.Edges[0].To)
+
+	require.Len(t, out.Builds, 2)
+	require.Equal(t, 1, out.Builds[0].ID)
+	require.Zero(t, out.Builds[0].Parent)
+	require.NotEmpty(t, out.Builds[0].Start)
+	require.Equal(t, "db", out.Builds[1].Name)
+	require.Equal(t, 1, out.Builds[1].Parent)
+	require.Equal(t, "dial failed", out.Builds[1].Error)
+}
+
+func TestBuildGraph_WriteDOT(t *testing.T) {
+	ctx, g := WithBuildGraph(context.Background())
+	db := Named("db", BuilderFunc[string](func(ctx context.Context) (string, error) {
+		return "", errors.New("dial failed")
+	}))
+	_, err := Named("app", db).Build(ctx)
+	require.Error(t, err)
+
+	var buf bytes.Buffer
+	err = g.WriteDOT(&buf)
+	require.NoError(t, err)
+
+	dot := buf.String()
+	require.Contains(t, dot, "digraph bedrock {\n")
+	require.Contains(t, dot, `"app" [label="app\n`)
+	require.Regexp(t, `"db" \[label="db\\n[^"]*", color=red\];`, dot)
+	require.Contains(t, dot, `"app" -> "db";`)
+}
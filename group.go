@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/z5labs/bedrock/internal/fixedpool"
+)
+
+// ErrUnexpectedExit is returned, wrapped in a *MemberError, when a Group member
+// which is not allowed to exit returns before the Group is stopped.
+var ErrUnexpectedExit = errors.New("runtime exited unexpectedly")
+
+// MemberError records which member of a Group failed.
+type MemberError struct {
+	// Name is the name of the member given to [Member].
+	Name string
+
+	// Err is the error returned by the member.
+	Err error
+}
+
+// Error implements the [error] interface.
+func (e *MemberError) Error() string {
+	return fmt.Sprintf("group member %q: %v", e.Name, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *MemberError) Unwrap() error {
+	return e.Err
+}
+
+// GroupMember is a named Runtime run as part of a [Group].
+type GroupMember struct {
+	name      string
+	runtime   Runtime
+	mayExit   bool
+	restarter *restarter
+}
+
+// MemberOption configures a GroupMember.
+type MemberOption func(*GroupMember)
+
+// AllowExit allows a member to return without an error while the rest of the
+// Group keeps running, e.g. a one-off migration or cache warmer. By default,
+// a member which returns before the Group is stopped is treated as a failure.
+func AllowExit() MemberOption {
+	return func(m *GroupMember) {
+		m.mayExit = true
+	}
+}
+
+// Member returns a GroupMember which runs rt under the given name.
+func Member(name string, rt Runtime, opts ...MemberOption) GroupMember {
+	m := GroupMember{
+		name:    name,
+		runtime: rt,
+	}
+	for _, opt := range opts {
+		opt(&m)
+	}
+	return m
+}
+
+// Group returns a Runtime which runs each member concurrently until all of them return.
+//
+// When a member fails, or returns before the Group is stopped without [AllowExit],
+// the context passed to the other members is cancelled. Every failure is returned
+// as a *MemberError identifying the member. If more than one member fails their
+// errors are joined. Panics are recovered and reported as failures of the member.
+//
+// Errors wrapping [context.Canceled] returned by members once the Group has been
+// stopped, either by ctx or by another member failing, are ignored.
+//
+// The Group reports that it is ready, see [Ready], once every member has either
+// reported that it is ready or returned.
+func Group(members ...GroupMember) Runtime {
+	return RuntimeFunc(func(ctx context.Context) error {
+		tasks := make([]fixedpool.Task, len(members))
+		for i, m := range members {
+			tasks[i] = m.task
+		}
+		if notifiesReady(ctx) {
+			tasks = readyWhenAllReady(ctx, tasks)
+		}
+		return fixedpool.Wait(ctx, tasks...)
+	})
+}
+
+// readyWhenAllReady wraps tasks so that [Ready] is called for ctx once every
+// task has either called Ready itself or returned.
+func readyWhenAllReady(ctx context.Context, tasks []fixedpool.Task) []fixedpool.Task {
+	var wg sync.WaitGroup
+	wg.Add(len(tasks))
+	go func() {
+		wg.Wait()
+		if ctx.Err() == nil {
+			Ready(ctx)
+		}
+	}()
+
+	wrapped := make([]fixedpool.Task, len(tasks))
+	for i, task := range tasks {
+		wrapped[i] = func(ctx context.Context) error {
+			var once sync.Once
+			done := func() {
+				once.Do(wg.Done)
+			}
+			defer done()
+
+			ctx, ready := NotifyReady(ctx)
+			go func() {
+				select {
+				case <-ready:
+					done()
+				case <-ctx.Done():
+				}
+			}()
+
+			return task(ctx)
+		}
+	}
+	return wrapped
+}
+
+func (m GroupMember) task(ctx context.Context) error {
+	var err error
+	if m.restarter != nil {
+		err = m.restarter.run(ctx, m.runOnce)
+	} else {
+		err = m.runOnce(ctx)
+	}
+
+	if err == nil || (ctx.Err() != nil && errors.Is(err, context.Canceled)) {
+		return nil
+	}
+	return &MemberError{Name: m.name, Err: err}
+}
+
+// runOnce runs the member, turning panics and unexpected exits into errors.
+func (m GroupMember) runOnce(ctx context.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = NewPanicError(r)
+		}
+	}()
+
+	err = m.runtime.Run(ctx)
+	if err == nil && !m.mayExit && ctx.Err() == nil {
+		return ErrUnexpectedExit
+	}
+	return err
+}
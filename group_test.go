@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// blockingRuntime runs until its context is cancelled.
+func blockingRuntime() Runtime {
+	return RuntimeFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+}
+
+func TestGroup(t *testing.T) {
+	t.Run("runs until the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		started := make(chan struct{}, 2)
+		member := RuntimeFunc(func(ctx context.Context) error {
+			started <- struct{}{}
+			<-ctx.Done()
+			return nil
+		})
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- Group(
+				Member("api", member),
+				Member("admin", member),
+			).Run(ctx)
+		}()
+
+		<-started
+		<-started
+		cancel()
+
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("group did not stop")
+		}
+	})
+
+	t.Run("ignores context.Canceled once stopped", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := Group(
+			Member("api", blockingRuntime()),
+			Member("worker", blockingRuntime()),
+		).Run(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("cancels the other members when one fails", func(t *testing.T) {
+		runErr := errors.New("bind failed")
+
+		err := Group(
+			Member("api", RuntimeFunc(func(ctx context.Context) error {
+				return runErr
+			})),
+			Member("worker", blockingRuntime()),
+		).Run(context.Background())
+		require.ErrorIs(t, err, runErr)
+
+		var memberErr *MemberError
+		require.ErrorAs(t, err, &memberErr)
+		require.Equal(t, "api", memberErr.Name)
+		require.Equal(t, `group member "api": bind failed`, err.Error())
+	})
+
+	t.Run("joins errors from multiple failed members", func(t *testing.T) {
+		apiErr := errors.New("api failed")
+		workerErr := errors.New("worker failed")
+
+		err := Group(
+			Member("api", RuntimeFunc(func(ctx context.Context) error {
+				return apiErr
+			})),
+			Member("worker", RuntimeFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return workerErr
+			})),
+		).Run(context.Background())
+		require.ErrorIs(t, err, apiErr)
+		require.ErrorIs(t, err, workerErr)
+		require.ErrorContains(t, err, `group member "api"`)
+		require.ErrorContains(t, err, `group member "worker"`)
+	})
+
+	t.Run("treats an unexpected exit as a failure", func(t *testing.T) {
+		err := Group(
+			Member("api", blockingRuntime()),
+			Member("worker", RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			})),
+		).Run(context.Background())
+		require.ErrorIs(t, err, ErrUnexpectedExit)
+
+		var memberErr *MemberError
+		require.ErrorAs(t, err, &memberErr)
+		require.Equal(t, "worker", memberErr.Name)
+	})
+
+	t.Run("keeps running when a member allowed to exit returns", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		migrated := make(chan struct{})
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- Group(
+				Member("api", blockingRuntime()),
+				Member("migrate", RuntimeFunc(func(ctx context.Context) error {
+					close(migrated)
+					return nil
+				}), AllowExit()),
+			).Run(ctx)
+		}()
+
+		<-migrated
+		select {
+		case err := <-errCh:
+			t.Fatalf("group stopped early: %v", err)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("returns once every member allowed to exit has returned", func(t *testing.T) {
+		noop := RuntimeFunc(func(ctx context.Context) error {
+			return nil
+		})
+
+		err := Group(
+			Member("first", noop, AllowExit()),
+			Member("second", noop, AllowExit()),
+		).Run(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("still reports errors from members allowed to exit", func(t *testing.T) {
+		runErr := errors.New("migration failed")
+
+		err := Group(
+			Member("api", blockingRuntime()),
+			Member("migrate", RuntimeFunc(func(ctx context.Context) error {
+				return runErr
+			}), AllowExit()),
+		).Run(context.Background())
+		require.ErrorIs(t, err, runErr)
+
+		var memberErr *MemberError
+		require.ErrorAs(t, err, &memberErr)
+		require.Equal(t, "migrate", memberErr.Name)
+	})
+
+	t.Run("recovers panics as member errors", func(t *testing.T) {
+		err := Group(
+			Member("api", blockingRuntime()),
+			Member("worker", RuntimeFunc(func(ctx context.Context) error {
+				panic("boom")
+			})),
+		).Run(context.Background())
+		require.ErrorContains(t, err, `group member "worker": recovered from panic: boom`)
+	})
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package health provides liveness and readiness checks for bedrock applications.
+//
+// A Monitor runs named Checkers, each with its own timeout and optional result caching,
+// and aggregates them into liveness and readiness Reports:
+//
+//	m := health.NewMonitor(
+//	    health.LivenessCheck("deadlock", deadlockChecker),
+//	    health.ReadinessCheck("db", health.CheckerFunc(db.PingContext),
+//	        health.Timeout(time.Second),
+//	        health.CacheFor(5*time.Second),
+//	    ),
+//	)
+//
+// # Runtime State
+//
+// Track wraps a bedrock.Runtime so the Monitor reports "not ready" while the Runtime is
+// starting, i.e. until it calls bedrock.Ready, and again once it begins shutting down:
+//
+//	rt := bedrock.Map(appBuilder, func(ctx context.Context, app bedrock.Runtime) (bedrock.Runtime, error) {
+//	    return m.Track(app), nil
+//	})
+//
+// Until then, the Monitor reports the application as starting. Runtimes which never
+// call bedrock.Ready can be tracked with ReadyOnRun, so they are ready once they run.
+//
+// Alternatively, the Monitor is a bedrock.Observer, so it can follow the lifecycle
+// events published by a bedrock.Observe Runner instead:
+//
+//	runner := bedrock.Observe(bedrock.DefaultRunner[bedrock.Runtime](), m)
+//
+// # HTTP
+//
+// Handler serves the liveness Report on /livez and the readiness Report on /readyz as JSON.
+// It can be mounted on an existing server or run as its own Runtime with BuildRuntime.
+//
+// # Metrics
+//
+// The status of every check is exposed through OpenTelemetry as the
+// bedrock.health.check.status gauge, which is 1 while the check passes and 0 otherwise.
+package health
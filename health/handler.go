@@ -0,0 +1,47 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+
+	"github.com/z5labs/bedrock"
+	httpruntime "github.com/z5labs/bedrock/runtime/http"
+)
+
+// Handler returns an http.Handler which serves the liveness Report on /livez and
+// the readiness Report on /readyz as JSON. It responds with 200 OK when the Report
+// Status is up and 503 Service Unavailable otherwise.
+func Handler(m *Monitor) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("GET /livez", reportHandler(m.Liveness))
+	mux.Handle("GET /readyz", reportHandler(m.Readiness))
+	return mux
+}
+
+func reportHandler(report func(context.Context) Report) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rep := report(r.Context())
+
+		status := http.StatusOK
+		if rep.Status != StatusUp {
+			status = http.StatusServiceUnavailable
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		json.NewEncoder(w).Encode(rep) //nolint:errcheck
+	})
+}
+
+// BuildRuntime returns a Builder for a dedicated HTTP server which serves [Handler]
+// on the given listener, e.g. on a separate admin port.
+func BuildRuntime(m *Monitor, listener bedrock.Builder[net.Listener], opts ...httpruntime.ServerOption) bedrock.Builder[httpruntime.Runtime] {
+	return httpruntime.Build(listener, bedrock.BuilderOf(Handler(m)), opts...)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	m := markRunning(NewMonitor(
+		LivenessCheck("deadlock", passing()),
+		ReadinessCheck("db", failing(errors.New("unreachable"))),
+	))
+	h := Handler(m)
+
+	testCases := []struct {
+		name       string
+		path       string
+		statusCode int
+		status     Status
+	}{
+		{
+			name:       "liveness",
+			path:       "/livez",
+			statusCode: http.StatusOK,
+			status:     StatusUp,
+		},
+		{
+			name:       "readiness",
+			path:       "/readyz",
+			statusCode: http.StatusServiceUnavailable,
+			status:     StatusDown,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			r := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			h.ServeHTTP(w, r)
+
+			require.Equal(t, tc.statusCode, w.Code)
+			require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+			var rep Report
+			err := json.Unmarshal(w.Body.Bytes(), &rep)
+			require.NoError(t, err)
+			require.Equal(t, tc.status, rep.Status)
+			require.Len(t, rep.Checks, 1)
+		})
+	}
+}
+
+func TestBuildRuntime(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	m := NewMonitor()
+	rt, err := BuildRuntime(m, bedrock.BuilderOf(ln)).Build(context.Background())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- rt.Run(ctx)
+	}()
+
+	var resp *http.Response
+	require.Eventually(t, func() bool {
+		resp, err = http.Get("http://" + ln.Addr().String() + "/livez")
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
@@ -0,0 +1,367 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/z5labs/bedrock"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+// Checker reports whether a component is healthy by returning a nil error.
+type Checker interface {
+	Check(context.Context) error
+}
+
+// CheckerFunc is a function type that implements the Checker interface.
+type CheckerFunc func(context.Context) error
+
+// Check implements the [Checker] interface for CheckerFunc.
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+// Status describes the health of a check or of a whole Report.
+type Status string
+
+const (
+	// StatusUp means every check passed.
+	StatusUp Status = "up"
+
+	// StatusDown means at least one check failed.
+	StatusDown Status = "down"
+
+	// StatusStarting means a tracked Runtime has not reported that it is ready yet.
+	StatusStarting Status = "starting"
+
+	// StatusDraining means a tracked Runtime is shutting down.
+	StatusDraining Status = "draining"
+)
+
+// Result is the outcome of running a single check.
+type Result struct {
+	Name      string        `json:"name"`
+	Status    Status        `json:"status"`
+	Error     string        `json:"error,omitempty"`
+	Duration  time.Duration `json:"duration"`
+	CheckedAt time.Time     `json:"checked_at"`
+}
+
+// Report aggregates the results of every liveness or readiness check.
+type Report struct {
+	Status Status   `json:"status"`
+	Checks []Result `json:"checks"`
+}
+
+// CheckOption configures a named check.
+type CheckOption func(*check)
+
+// Timeout bounds how long a single run of the check may take.
+//
+// Default is 5 seconds.
+func Timeout(d time.Duration) CheckOption {
+	return func(c *check) {
+		c.timeout = d
+	}
+}
+
+// CacheFor reuses the result of the check for d instead of running it on every
+// request, which protects expensive dependencies from frequent probes.
+//
+// By default, results are not cached.
+func CacheFor(d time.Duration) CheckOption {
+	return func(c *check) {
+		c.ttl = d
+	}
+}
+
+type probe string
+
+const (
+	liveness  probe = "liveness"
+	readiness probe = "readiness"
+)
+
+type check struct {
+	name    string
+	probe   probe
+	checker Checker
+	timeout time.Duration
+	ttl     time.Duration
+
+	mu     sync.Mutex
+	last   Result
+	hasRun bool
+}
+
+func newCheck(name string, p probe, c Checker, opts ...CheckOption) *check {
+	chk := &check{
+		name:    name,
+		probe:   p,
+		checker: c,
+		timeout: 5 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(chk)
+	}
+	return chk
+}
+
+// run runs the check, or returns its cached result. The lock is not held while
+// the Checker runs, so a slow check never blocks other probes beyond its timeout.
+func (c *check) run(ctx context.Context) Result {
+	if res, ok := c.cachedResult(); ok {
+		return res
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := c.checker.Check(ctx)
+
+	res := Result{
+		Name:      c.name,
+		Status:    StatusUp,
+		Duration:  time.Since(start),
+		CheckedAt: start,
+	}
+	if err != nil {
+		res.Status = StatusDown
+		res.Error = err.Error()
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.hasRun || res.CheckedAt.After(c.last.CheckedAt) {
+		c.last = res
+		c.hasRun = true
+	}
+	return res
+}
+
+// cachedResult returns the most recent result of the check if it is cached
+// and has not expired yet.
+func (c *check) cachedResult() (Result, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.hasRun && c.ttl > 0 && time.Since(c.last.CheckedAt) < c.ttl {
+		return c.last, true
+	}
+	return Result{}, false
+}
+
+// lastResult returns the most recent result of the check without running it.
+func (c *check) lastResult() (Result, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.last, c.hasRun
+}
+
+// Option configures a Monitor.
+type Option func(*Monitor)
+
+// LivenessCheck registers a check which reports whether the application is alive.
+// A failing liveness check usually causes the application to be restarted.
+func LivenessCheck(name string, c Checker, opts ...CheckOption) Option {
+	return func(m *Monitor) {
+		m.checks = append(m.checks, newCheck(name, liveness, c, opts...))
+	}
+}
+
+// ReadinessCheck registers a check which reports whether the application can
+// serve traffic. A failing readiness check usually removes the application from
+// load balancing without restarting it.
+func ReadinessCheck(name string, c Checker, opts ...CheckOption) Option {
+	return func(m *Monitor) {
+		m.checks = append(m.checks, newCheck(name, readiness, c, opts...))
+	}
+}
+
+// MeterProvider sets the MeterProvider used to report check results.
+//
+// Default is the global MeterProvider.
+func MeterProvider(mp metric.MeterProvider) Option {
+	return func(m *Monitor) {
+		m.meterProvider = mp
+	}
+}
+
+// Monitor runs liveness and readiness checks and tracks the state of a Runtime.
+// A Monitor is safe for concurrent use.
+type Monitor struct {
+	checks        []*check
+	meterProvider metric.MeterProvider
+
+	state atomic.Value
+}
+
+// NewMonitor returns a Monitor running the given checks.
+//
+// The Monitor reports the application as starting, and therefore not ready, until
+// a Runtime tracked with Track, or the application observed with Observe, is ready.
+func NewMonitor(opts ...Option) *Monitor {
+	m := &Monitor{
+		meterProvider: otel.GetMeterProvider(),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	m.state.Store(StatusStarting)
+
+	meter := m.meterProvider.Meter("github.com/z5labs/bedrock/health")
+	_, err := meter.Int64ObservableGauge(
+		"bedrock.health.check.status",
+		metric.WithDescription("Whether the health check is passing (1) or failing (0)."),
+		metric.WithInt64Callback(m.observe),
+	)
+	if err != nil {
+		otel.Handle(err)
+	}
+	return m
+}
+
+func (m *Monitor) observe(ctx context.Context, o metric.Int64Observer) error {
+	for _, c := range m.checks {
+		res, ok := c.lastResult()
+		if !ok {
+			continue
+		}
+
+		var v int64
+		if res.Status == StatusUp {
+			v = 1
+		}
+		o.Observe(v, metric.WithAttributes(
+			attribute.String("check", c.name),
+			attribute.String("probe", string(c.probe)),
+		))
+	}
+	return nil
+}
+
+// Liveness runs every liveness check concurrently and reports whether all of them passed.
+func (m *Monitor) Liveness(ctx context.Context) Report {
+	return m.report(ctx, liveness)
+}
+
+// Readiness runs every readiness check concurrently and reports whether all of them
+// passed. If a tracked Runtime is starting or draining, the Report has that Status
+// regardless of the checks.
+func (m *Monitor) Readiness(ctx context.Context) Report {
+	r := m.report(ctx, readiness)
+	if state := m.state.Load().(Status); state != StatusUp {
+		r.Status = state
+	}
+	return r
+}
+
+func (m *Monitor) report(ctx context.Context, p probe) Report {
+	var checks []*check
+	for _, c := range m.checks {
+		if c.probe == p {
+			checks = append(checks, c)
+		}
+	}
+
+	results := make([]Result, len(checks))
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Go(func() {
+			results[i] = c.run(ctx)
+		})
+	}
+	wg.Wait()
+
+	r := Report{
+		Status: StatusUp,
+		Checks: results,
+	}
+	for _, res := range results {
+		if res.Status != StatusUp {
+			r.Status = StatusDown
+			break
+		}
+	}
+	return r
+}
+
+// TrackOption configures how a Runtime tracked with Track becomes ready.
+type TrackOption func(*trackOptions)
+
+type trackOptions struct {
+	readyOnRun bool
+}
+
+// ReadyOnRun reports the tracked Runtime as ready as soon as it starts running,
+// for Runtimes which never call [bedrock.Ready].
+func ReadyOnRun() TrackOption {
+	return func(to *trackOptions) {
+		to.readyOnRun = true
+	}
+}
+
+// Track wraps rt so that the Monitor reports it as starting until rt calls
+// [bedrock.Ready] and as draining once the context passed to it is cancelled
+// or it returns.
+//
+// Runtimes which do not call bedrock.Ready are never reported as ready, unless
+// tracked with [ReadyOnRun].
+func (m *Monitor) Track(rt bedrock.Runtime, opts ...TrackOption) bedrock.Runtime {
+	to := &trackOptions{}
+	for _, opt := range opts {
+		opt(to)
+	}
+
+	return bedrock.RuntimeFunc(func(ctx context.Context) error {
+		state := StatusStarting
+		if to.readyOnRun {
+			state = StatusUp
+		}
+		m.state.Store(state)
+		defer m.state.Store(StatusDraining)
+
+		ctx, ready := bedrock.NotifyReady(ctx)
+		stop := context.AfterFunc(ctx, func() {
+			m.state.Store(StatusDraining)
+		})
+		defer stop()
+
+		go func() {
+			select {
+			case <-ready:
+				m.state.CompareAndSwap(StatusStarting, StatusUp)
+			case <-ctx.Done():
+			}
+		}()
+
+		return rt.Run(ctx)
+	})
+}
+
+// Observe implements the [bedrock.Observer] interface, so that a Monitor registered
+// with a bedrock.Observe Runner reports the application as starting until it is
+// running and as draining once it starts shutting down. It is an alternative to
+// wrapping the Runtime with Track.
+func (m *Monitor) Observe(ctx context.Context, e bedrock.LifecycleEvent) {
+	switch e.State {
+	case bedrock.StateRunning:
+		m.state.Store(StatusUp)
+	case bedrock.StateDraining, bedrock.StateStopped, bedrock.StateFailed:
+		m.state.Store(StatusDraining)
+	default:
+		m.state.Store(StatusStarting)
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package health
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func passing() Checker {
+	return CheckerFunc(func(ctx context.Context) error {
+		return nil
+	})
+}
+
+func failing(err error) Checker {
+	return CheckerFunc(func(ctx context.Context) error {
+		return err
+	})
+}
+
+func TestMonitor_Liveness(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     []Option
+		expected Status
+	}{
+		{
+			name:     "up without checks",
+			expected: StatusUp,
+		},
+		{
+			name: "up when every check passes",
+			opts: []Option{
+				LivenessCheck("a", passing()),
+				LivenessCheck("b", passing()),
+			},
+			expected: StatusUp,
+		},
+		{
+			name: "down when a check fails",
+			opts: []Option{
+				LivenessCheck("a", passing()),
+				LivenessCheck("b", failing(errors.New("deadlocked"))),
+			},
+			expected: StatusDown,
+		},
+		{
+			name: "ignores readiness checks",
+			opts: []Option{
+				LivenessCheck("a", passing()),
+				ReadinessCheck("db", failing(errors.New("unreachable"))),
+			},
+			expected: StatusUp,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := NewMonitor(tc.opts...)
+
+			r := m.Liveness(context.Background())
+			require.Equal(t, tc.expected, r.Status)
+		})
+	}
+}
+
+// markRunning reports the application observed by m as running.
+func markRunning(m *Monitor) *Monitor {
+	m.Observe(context.Background(), bedrock.LifecycleEvent{State: bedrock.StateRunning})
+	return m
+}
+
+func TestMonitor_Readiness(t *testing.T) {
+	t.Run("is not ready until the application is running", func(t *testing.T) {
+		m := NewMonitor(ReadinessCheck("db", passing()))
+		require.Equal(t, StatusStarting, m.Readiness(context.Background()).Status)
+
+		markRunning(m)
+		require.Equal(t, StatusUp, m.Readiness(context.Background()).Status)
+	})
+
+	t.Run("reports every check result", func(t *testing.T) {
+		m := markRunning(NewMonitor(
+			ReadinessCheck("cache", passing()),
+			ReadinessCheck("db", failing(errors.New("unreachable"))),
+		))
+
+		r := m.Readiness(context.Background())
+		require.Equal(t, StatusDown, r.Status)
+		require.Len(t, r.Checks, 2)
+		require.Equal(t, "cache", r.Checks[0].Name)
+		require.Equal(t, StatusUp, r.Checks[0].Status)
+		require.Equal(t, "db", r.Checks[1].Name)
+		require.Equal(t, StatusDown, r.Checks[1].Status)
+		require.Equal(t, "unreachable", r.Checks[1].Error)
+	})
+
+	t.Run("times out slow checks", func(t *testing.T) {
+		slow := CheckerFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		m := markRunning(NewMonitor(ReadinessCheck("slow", slow, Timeout(10*time.Millisecond))))
+
+		r := m.Readiness(context.Background())
+		require.Equal(t, StatusDown, r.Status)
+		require.Equal(t, context.DeadlineExceeded.Error(), r.Checks[0].Error)
+	})
+
+	t.Run("caches results", func(t *testing.T) {
+		var calls atomic.Int64
+		counting := CheckerFunc(func(ctx context.Context) error {
+			calls.Add(1)
+			return nil
+		})
+		m := NewMonitor(ReadinessCheck("db", counting, CacheFor(time.Hour)))
+
+		m.Readiness(context.Background())
+		m.Readiness(context.Background())
+		require.Equal(t, int64(1), calls.Load())
+	})
+
+	t.Run("does not cache results by default", func(t *testing.T) {
+		var calls atomic.Int64
+		counting := CheckerFunc(func(ctx context.Context) error {
+			calls.Add(1)
+			return nil
+		})
+		m := NewMonitor(ReadinessCheck("db", counting))
+
+		m.Readiness(context.Background())
+		m.Readiness(context.Background())
+		require.Equal(t, int64(2), calls.Load())
+	})
+
+	t.Run("does not block other probes while a check runs", func(t *testing.T) {
+		block := make(chan struct{})
+		defer close(block)
+
+		var calls atomic.Int64
+		blocking := CheckerFunc(func(ctx context.Context) error {
+			if calls.Add(1) == 1 {
+				<-block
+			}
+			return nil
+		})
+		m := markRunning(NewMonitor(ReadinessCheck("db", blocking, Timeout(time.Hour))))
+
+		go m.Readiness(context.Background())
+		require.Eventually(t, func() bool {
+			return calls.Load() == 1
+		}, 5*time.Second, time.Millisecond)
+
+		r := m.Readiness(context.Background())
+		require.Equal(t, StatusUp, r.Status)
+		require.Equal(t, int64(2), calls.Load())
+	})
+}
+
+func TestMonitor_Track(t *testing.T) {
+	m := NewMonitor(ReadinessCheck("db", passing()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	started := make(chan struct{})
+	ready := make(chan struct{})
+	rt := m.Track(bedrock.RuntimeFunc(func(ctx context.Context) error {
+		close(started)
+		<-ready
+		bedrock.Ready(ctx)
+		<-ctx.Done()
+		return nil
+	}))
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- rt.Run(ctx)
+	}()
+
+	<-started
+	require.Equal(t, StatusStarting, m.Readiness(context.Background()).Status)
+
+	close(ready)
+	require.Eventually(t, func() bool {
+		return m.Readiness(context.Background()).Status == StatusUp
+	}, 5*time.Second, time.Millisecond)
+
+	cancel()
+	require.Eventually(t, func() bool {
+		return m.Readiness(context.Background()).Status == StatusDraining
+	}, 5*time.Second, time.Millisecond)
+
+	require.NoError(t, <-errCh)
+	require.Equal(t, StatusDraining, m.Readiness(context.Background()).Status)
+	require.Equal(t, StatusUp, m.Liveness(context.Background()).Status)
+}
+
+func TestMonitor_Track_ReadyOnRun(t *testing.T) {
+	m := NewMonitor()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	started := make(chan struct{})
+	rt := m.Track(bedrock.RuntimeFunc(func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		return nil
+	}), ReadyOnRun())
+
+	require.Equal(t, StatusStarting, m.Readiness(context.Background()).Status)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- rt.Run(ctx)
+	}()
+
+	<-started
+	require.Equal(t, StatusUp, m.Readiness(context.Background()).Status)
+
+	cancel()
+	require.NoError(t, <-errCh)
+	require.Equal(t, StatusDraining, m.Readiness(context.Background()).Status)
+}
+
+func TestMonitor_Track_Group(t *testing.T) {
+	m := NewMonitor()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	apiReady := make(chan struct{})
+	rt := m.Track(bedrock.Group(
+		bedrock.Member("api", bedrock.RuntimeFunc(func(ctx context.Context) error {
+			<-apiReady
+			bedrock.Ready(ctx)
+			<-ctx.Done()
+			return nil
+		})),
+		bedrock.Member("worker", bedrock.RuntimeFunc(func(ctx context.Context) error {
+			bedrock.Ready(ctx)
+			<-ctx.Done()
+			return nil
+		})),
+	))
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- rt.Run(ctx)
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, StatusStarting, m.Readiness(context.Background()).Status)
+
+	close(apiReady)
+	require.Eventually(t, func() bool {
+		return m.Readiness(context.Background()).Status == StatusUp
+	}, 5*time.Second, time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
+func TestMonitor_Metrics(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	m := NewMonitor(
+		MeterProvider(mp),
+		LivenessCheck("deadlock", passing()),
+		ReadinessCheck("db", failing(errors.New("unreachable"))),
+	)
+	m.Liveness(context.Background())
+	m.Readiness(context.Background())
+
+	var rm metricdata.ResourceMetrics
+	err := reader.Collect(context.Background(), &rm)
+	require.NoError(t, err)
+
+	require.Len(t, rm.ScopeMetrics, 1)
+	require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+
+	metric := rm.ScopeMetrics[0].Metrics[0]
+	require.Equal(t, "bedrock.health.check.status", metric.Name)
+
+	gauge, ok := metric.Data.(metricdata.Gauge[int64])
+	require.True(t, ok)
+
+	values := make(map[string]int64)
+	for _, dp := range gauge.DataPoints {
+		name, _ := dp.Attributes.Value("check")
+		values[name.AsString()] = dp.Value
+	}
+	require.Equal(t, map[string]int64{"deadlock": 1, "db": 0}, values)
+}
+
+func TestMonitor_Observe(t *testing.T) {
+	m := NewMonitor()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ready := make(chan struct{})
+	builder := bedrock.BuilderFunc[bedrock.Runtime](func(ctx context.Context) (bedrock.Runtime, error) {
+		require.Equal(t, StatusStarting, m.Readiness(context.Background()).Status)
+
+		return bedrock.RuntimeFunc(func(ctx context.Context) error {
+			<-ready
+			bedrock.Ready(ctx)
+			<-ctx.Done()
+			return nil
+		}), nil
+	})
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- bedrock.Observe(bedrock.DefaultRunner[bedrock.Runtime](), m).Run(ctx, builder)
+	}()
+
+	close(ready)
+	require.Eventually(t, func() bool {
+		return m.Readiness(context.Background()).Status == StatusUp
+	}, 5*time.Second, time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-errCh)
+	require.Equal(t, StatusDraining, m.Readiness(context.Background()).Status)
+}
@@ -8,12 +8,18 @@ package fixedpool
 import (
 	"context"
 	"errors"
-	"fmt"
 	"sync"
+
+	"github.com/z5labs/bedrock/internal/panicerr"
 )
 
+// Task is a unit of work run by Wait.
 type Task func(context.Context) error
 
+// Wait runs each task on its own goroutine and waits for all of them to return.
+// The first task to fail cancels the context passed to the others. If a single
+// task fails its error is returned as is, otherwise the errors are joined.
+// Panics are recovered and returned as a *panicerr.Error.
 func Wait(ctx context.Context, tasks ...Task) error {
 	ctx, cancel := context.WithCancelCause(ctx)
 	defer cancel(nil)
@@ -31,11 +37,12 @@ func Wait(ctx context.Context, tasks ...Task) error {
 				// Must be called directly in defer for recover() to work
 				r := recover()
 				if r != nil {
-					rerr, ok := r.(error)
-					if !ok {
-						rerr = fmt.Errorf("recovered from panic: %v", r)
+					perr := panicerr.New(r)
+					if err == nil {
+						err = perr
+					} else {
+						err = errors.Join(err, perr)
 					}
-					err = errors.Join(err, rerr)
 				}
 				if err != nil {
 					errCh <- err
@@ -50,9 +57,12 @@ func Wait(ctx context.Context, tasks ...Task) error {
 	wg.Wait()
 	close(errCh)
 
-	var jerr error
+	errs := make([]error, 0, len(tasks))
 	for err := range errCh {
-		jerr = errors.Join(jerr, err)
+		errs = append(errs, err)
+	}
+	if len(errs) == 1 {
+		return errs[0]
 	}
-	return jerr
+	return errors.Join(errs...)
 }
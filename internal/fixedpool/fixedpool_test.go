@@ -8,10 +8,13 @@ package fixedpool
 import (
 	"context"
 	"errors"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/z5labs/bedrock/internal/panicerr"
 )
 
 func TestWait_AllTasksSucceed(t *testing.T) {
@@ -99,6 +102,26 @@ func TestWait_OneTaskReturnsError(t *testing.T) {
 	}
 }
 
+func TestWait_SingleErrorIsNotJoined(t *testing.T) {
+	ctx := context.Background()
+
+	expectedErr := errors.New("task error")
+
+	tasks := []Task{
+		func(ctx context.Context) error {
+			return nil
+		},
+		func(ctx context.Context) error {
+			return expectedErr
+		},
+	}
+
+	err := Wait(ctx, tasks...)
+	if err != expectedErr {
+		t.Errorf("Wait() error = %#v, want %#v", err, expectedErr)
+	}
+}
+
 func TestWait_MultipleTasksReturnErrors(t *testing.T) {
 	ctx := context.Background()
 
@@ -170,6 +193,32 @@ func TestWait_TaskPanicsWithNonError(t *testing.T) {
 	}
 }
 
+func TestWait_TaskPanicsWithStack(t *testing.T) {
+	ctx := context.Background()
+
+	tasks := []Task{
+		func(ctx context.Context) error {
+			panic("panic string")
+		},
+	}
+
+	err := Wait(ctx, tasks...)
+
+	var perr *panicerr.Error
+	if !errors.As(err, &perr) {
+		t.Fatalf("Wait() error = %T, want *panicerr.Error", err)
+	}
+	if perr.Value != "panic string" {
+		t.Errorf("Value = %v, want %q", perr.Value, "panic string")
+	}
+	if !strings.Contains(string(perr.Stack), "fixedpool.TestWait_TaskPanicsWithStack") {
+		t.Errorf("Stack does not contain the panicking task:\n%s", perr.Stack)
+	}
+	if !strings.Contains(perr.Goroutine, "fixedpool.Wait") {
+		t.Errorf("Goroutine = %q, want fixedpool.Wait", perr.Goroutine)
+	}
+}
+
 func TestWait_TaskPanicsAfterReturningError(t *testing.T) {
 	ctx := context.Background()
 
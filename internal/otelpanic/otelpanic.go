@@ -0,0 +1,65 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package otelpanic records recovered panics using only the OpenTelemetry API,
+// so packages which record them, such as rest, do not depend on the SDK. It is
+// exported by the otel package as RecordPanic.
+package otelpanic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/z5labs/bedrock/internal/panicerr"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/z5labs/bedrock/runtime/otel"
+
+// Record records err on the span in ctx and emits it as an error log record
+// using the global LoggerProvider, if err is or wraps a *panicerr.Error. It
+// reports whether err was a panic.
+func Record(ctx context.Context, err error) bool {
+	var panicErr *panicerr.Error
+	if !errors.As(err, &panicErr) {
+		return false
+	}
+
+	excType := fmt.Sprintf("%T", panicErr.Value)
+	excMessage := fmt.Sprint(panicErr.Value)
+	excStack := string(panicErr.Stack)
+
+	span := trace.SpanFromContext(ctx)
+	span.AddEvent("exception", trace.WithAttributes(
+		attribute.String("exception.type", excType),
+		attribute.String("exception.message", excMessage),
+		attribute.String("exception.stacktrace", excStack),
+	))
+	span.SetStatus(codes.Error, panicErr.Error())
+
+	var rec log.Record
+	rec.SetTimestamp(time.Now())
+	rec.SetSeverity(log.SeverityError)
+	rec.SetSeverityText("ERROR")
+	rec.SetBody(attribute.StringValue(panicErr.Error()))
+	rec.AddAttributes(
+		attribute.String("exception.type", excType),
+		attribute.String("exception.message", excMessage),
+		attribute.String("exception.stacktrace", excStack),
+	)
+	if panicErr.Goroutine != "" {
+		rec.AddAttributes(attribute.String("goroutine.created_by", panicErr.Goroutine))
+	}
+	global.GetLoggerProvider().Logger(instrumentationName).Emit(ctx, rec)
+
+	return true
+}
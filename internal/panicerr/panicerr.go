@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package panicerr defines the error used to report recovered panics, which is
+// exported by the bedrock package as PanicError.
+package panicerr
+
+import (
+	"bytes"
+	"fmt"
+	"runtime/debug"
+)
+
+// Error reports a recovered panic.
+type Error struct {
+	// Value is the value passed to panic.
+	Value any
+
+	// Stack is the stack trace of the panicking goroutine, formatted
+	// as by [runtime/debug.Stack].
+	Stack []byte
+
+	// Goroutine is the function which started the panicking goroutine,
+	// or empty if the panic occurred on the main goroutine.
+	Goroutine string
+}
+
+// New returns an Error for value, which was returned by recover. It must be
+// called by the deferred function which recovered the panic so that the
+// stack trace includes where the panic occurred.
+//
+// If value is already an *Error, e.g. because a panic was recovered and
+// re-raised on another goroutine, it is returned as is.
+func New(value any) *Error {
+	if err, ok := value.(*Error); ok {
+		return err
+	}
+
+	stack := debug.Stack()
+	return &Error{
+		Value:     value,
+		Stack:     stack,
+		Goroutine: createdBy(stack),
+	}
+}
+
+// Error implements the [error] interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("recovered from panic: %v", e.Value)
+}
+
+// Unwrap returns Value if it is an error.
+func (e *Error) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// createdBy parses the function which started the goroutine from a stack trace.
+func createdBy(stack []byte) string {
+	const prefix = "created by "
+
+	i := bytes.LastIndex(stack, []byte("\n"+prefix))
+	if i < 0 {
+		return ""
+	}
+	line := stack[i+1+len(prefix):]
+	if j := bytes.IndexByte(line, '\n'); j >= 0 {
+		line = line[:j]
+	}
+	if j := bytes.Index(line, []byte(" in goroutine ")); j >= 0 {
+		line = line[:j]
+	}
+	return string(line)
+}
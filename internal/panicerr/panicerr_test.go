@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package panicerr
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func recovered(f func()) (err *Error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = New(r)
+		}
+	}()
+	f()
+	return nil
+}
+
+func panicWithValue(v any) {
+	panic(v)
+}
+
+func TestNew(t *testing.T) {
+	err := recovered(func() {
+		panicWithValue("boom")
+	})
+
+	if err.Value != "boom" {
+		t.Errorf("Value = %v, want boom", err.Value)
+	}
+	if got, want := err.Error(), "recovered from panic: boom"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !strings.Contains(string(err.Stack), "panicerr.panicWithValue") {
+		t.Errorf("Stack does not contain the panicking function:\n%s", err.Stack)
+	}
+	if err.Unwrap() != nil {
+		t.Errorf("Unwrap() = %v, want nil", err.Unwrap())
+	}
+}
+
+func TestNew_ErrorValue(t *testing.T) {
+	cause := errors.New("cause")
+
+	err := recovered(func() {
+		panic(cause)
+	})
+
+	if !errors.Is(err, cause) {
+		t.Errorf("errors.Is(%v, cause) = false, want true", err)
+	}
+}
+
+func TestNew_Reraised(t *testing.T) {
+	inner := recovered(func() {
+		panic("boom")
+	})
+
+	outer := recovered(func() {
+		panic(inner)
+	})
+
+	if outer != inner {
+		t.Errorf("New() = %p, want the re-raised error %p", outer, inner)
+	}
+}
+
+func TestNew_Goroutine(t *testing.T) {
+	errCh := make(chan *Error, 1)
+	go func() {
+		errCh <- recovered(func() {
+			panic("boom")
+		})
+	}()
+
+	err := <-errCh
+	if !strings.Contains(err.Goroutine, "panicerr.TestNew_Goroutine") {
+		t.Errorf("Goroutine = %q, want the function which started the goroutine", err.Goroutine)
+	}
+}
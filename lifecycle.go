@@ -0,0 +1,228 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+)
+
+// LifecycleState is a stage in the lifecycle of an application run by an [Observe] Runner.
+type LifecycleState int
+
+const (
+	// StateBuilding means the application is being built.
+	StateBuilding LifecycleState = iota
+
+	// StateBuilt means the application has been built.
+	StateBuilt
+
+	// StateStarting means the Runtime is being started.
+	StateStarting
+
+	// StateRunning means the Runtime has reported that it is ready, see [Ready].
+	StateRunning
+
+	// StateDraining means the context passed to the Runtime has been cancelled
+	// and the Runtime is shutting down.
+	StateDraining
+
+	// StateStopped means the application returned without an error.
+	StateStopped
+
+	// StateFailed means the application failed to build or returned an error.
+	StateFailed
+)
+
+// String returns the lower case name of the state, e.g. "running".
+func (s LifecycleState) String() string {
+	switch s {
+	case StateBuilding:
+		return "building"
+	case StateBuilt:
+		return "built"
+	case StateStarting:
+		return "starting"
+	case StateRunning:
+		return "running"
+	case StateDraining:
+		return "draining"
+	case StateStopped:
+		return "stopped"
+	case StateFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// LifecycleEvent records the transition of an application to a new state.
+type LifecycleEvent struct {
+	// State is the state the application transitioned to.
+	State LifecycleState
+
+	// Time is when the transition happened.
+	Time time.Time
+
+	// Err is the error which caused the application to fail. It is only set
+	// for [StateFailed].
+	Err error
+}
+
+// Observer is notified of lifecycle events published by an [Observe] Runner.
+type Observer interface {
+	Observe(context.Context, LifecycleEvent)
+}
+
+// ObserverFunc is a function type that implements the Observer interface.
+type ObserverFunc func(context.Context, LifecycleEvent)
+
+// Observe implements the [Observer] interface for ObserverFunc.
+func (f ObserverFunc) Observe(ctx context.Context, e LifecycleEvent) {
+	f(ctx, e)
+}
+
+// lifecycle publishes the lifecycle events of a single run to its observers.
+type lifecycle struct {
+	// notify serializes the delivery of events, so observers are called one at
+	// a time, while mu only guards the fields below.
+	notify sync.Mutex
+
+	mu        sync.Mutex
+	observers []Observer
+	state     LifecycleState
+	published bool
+}
+
+// publish notifies every observer that the application transitioned to state.
+// States only ever move forwards, so transitions to an earlier state, or out of
+// a terminal state, are ignored.
+//
+// Observers are notified without holding mu, so they may register other observers
+// with OnLifecycle, which only receive the events published afterwards.
+func (l *lifecycle) publish(ctx context.Context, state LifecycleState, err error) {
+	l.notify.Lock()
+	defer l.notify.Unlock()
+
+	l.mu.Lock()
+	if l.published && (state <= l.state || l.state >= StateStopped) {
+		l.mu.Unlock()
+		return
+	}
+	l.state = state
+	l.published = true
+	observers := slices.Clone(l.observers)
+	l.mu.Unlock()
+
+	e := LifecycleEvent{
+		State: state,
+		Time:  time.Now(),
+		Err:   err,
+	}
+	for _, o := range observers {
+		o.Observe(ctx, e)
+	}
+}
+
+func (l *lifecycle) add(o Observer) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.observers = append(l.observers, o)
+}
+
+type lifecycleKey struct{}
+
+// OnLifecycle registers o with the [Observe] Runner running the application, e.g. so
+// that a component can react to the application starting to serve or drain. The
+// Observer only receives the events published after it is registered. OnLifecycle is
+// a no-op unless ctx was passed down from an Observe Runner.
+func OnLifecycle(ctx context.Context, o Observer) {
+	l, ok := ctx.Value(lifecycleKey{}).(*lifecycle)
+	if !ok {
+		return
+	}
+	l.add(o)
+}
+
+// Observe wraps runner to publish the lifecycle events of the application to the
+// given observers, along with any registered with [OnLifecycle]:
+//
+//   - StateBuilding once the application starts being built
+//   - StateBuilt and then StateStarting once it has been built
+//   - StateRunning once the Runtime reports that it is ready, see [Ready]
+//   - StateDraining once the context passed to the Runtime is cancelled
+//   - StateStopped or StateFailed once runner returns
+//
+// Errors wrapping [context.Canceled] returned once ctx is cancelled are treated as
+// stopping cleanly. Readiness is passed on to whoever is waiting for it on ctx.
+//
+// Observers are called one at a time, in the order they were registered, on the
+// goroutine making the transition, so they should return quickly. Each state is
+// published at most once, even if the application is rebuilt, e.g. by [HotReload].
+func Observe[T Runtime](runner Runner[T], observers ...Observer) Runner[T] {
+	return RunnerFunc[T](func(ctx context.Context, builder Builder[T]) error {
+		l := &lifecycle{observers: slices.Clone(observers)}
+
+		outer := ctx
+		ctx, ready := NotifyReady(context.WithValue(ctx, lifecycleKey{}, l))
+
+		done := make(chan struct{})
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		defer close(done)
+
+		wg.Go(func() {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				l.publish(ctx, StateDraining, nil)
+				return
+			case <-ready:
+				Ready(outer)
+				l.publish(ctx, StateRunning, nil)
+			}
+
+			select {
+			case <-done:
+			case <-ctx.Done():
+				l.publish(ctx, StateDraining, nil)
+			}
+		})
+
+		observed := BuilderFunc[T](func(ctx context.Context) (T, error) {
+			l.publish(ctx, StateBuilding, nil)
+
+			app, err := builder.Build(ctx)
+			if err != nil {
+				return app, err
+			}
+
+			l.publish(ctx, StateBuilt, nil)
+			l.publish(ctx, StateStarting, nil)
+			return app, nil
+		})
+
+		err := runner.Run(ctx, observed)
+
+		// Publish draining here as well, since the goroutine watching ctx may not
+		// have got to it yet.
+		if ctx.Err() != nil {
+			l.publish(ctx, StateDraining, nil)
+		}
+
+		if err == nil || (ctx.Err() != nil && errors.Is(err, context.Canceled)) {
+			l.publish(ctx, StateStopped, nil)
+		} else {
+			l.publish(ctx, StateFailed, err)
+		}
+		return err
+	})
+}
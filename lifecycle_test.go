@@ -0,0 +1,191 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// lifecycleRecorder records the states published to it.
+type lifecycleRecorder struct {
+	mu     sync.Mutex
+	events []LifecycleEvent
+}
+
+func (r *lifecycleRecorder) Observe(ctx context.Context, e LifecycleEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *lifecycleRecorder) States() []LifecycleState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	states := make([]LifecycleState, len(r.events))
+	for i, e := range r.events {
+		states[i] = e.State
+	}
+	return states
+}
+
+func (r *lifecycleRecorder) Last() LifecycleEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.events[len(r.events)-1]
+}
+
+func TestObserve(t *testing.T) {
+	t.Run("publishes every transition of a clean run", func(t *testing.T) {
+		var rec lifecycleRecorder
+		ctx, cancel := context.WithCancel(context.Background())
+
+		rt := RuntimeFunc(func(ctx context.Context) error {
+			Ready(ctx)
+			require.Eventually(t, func() bool {
+				states := rec.States()
+				return len(states) > 0 && states[len(states)-1] == StateRunning
+			}, 5*time.Second, time.Millisecond)
+
+			cancel()
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		err := Observe(DefaultRunner[Runtime](), &rec).Run(ctx, BuilderOf[Runtime](rt))
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, []LifecycleState{
+			StateBuilding,
+			StateBuilt,
+			StateStarting,
+			StateRunning,
+			StateDraining,
+			StateStopped,
+		}, rec.States())
+	})
+
+	t.Run("publishes a failed build", func(t *testing.T) {
+		var rec lifecycleRecorder
+		buildErr := errors.New("failed")
+
+		err := Observe(DefaultRunner[Runtime](), &rec).Run(context.Background(), BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			return nil, buildErr
+		}))
+		require.Equal(t, buildErr, err)
+		require.Equal(t, []LifecycleState{StateBuilding, StateFailed}, rec.States())
+		require.Equal(t, buildErr, rec.Last().Err)
+	})
+
+	t.Run("publishes a failed runtime", func(t *testing.T) {
+		var rec lifecycleRecorder
+		runErr := errors.New("failed")
+
+		err := Observe(DefaultRunner[Runtime](), &rec).Run(context.Background(), BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			return runErr
+		})))
+		require.Equal(t, runErr, err)
+		require.Equal(t, []LifecycleState{StateBuilding, StateBuilt, StateStarting, StateFailed}, rec.States())
+		require.Equal(t, runErr, rec.Last().Err)
+	})
+
+	t.Run("notifies observers registered while building", func(t *testing.T) {
+		var rec lifecycleRecorder
+
+		builder := BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			OnLifecycle(ctx, &rec)
+			return RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			}), nil
+		})
+
+		err := Observe(DefaultRunner[Runtime]()).Run(context.Background(), builder)
+		require.NoError(t, err)
+		require.Equal(t, []LifecycleState{StateBuilt, StateStarting, StateStopped}, rec.States())
+	})
+
+	t.Run("notifies observers registered by other observers", func(t *testing.T) {
+		var rec lifecycleRecorder
+
+		var once sync.Once
+		registering := ObserverFunc(func(ctx context.Context, e LifecycleEvent) {
+			once.Do(func() {
+				OnLifecycle(ctx, &rec)
+			})
+		})
+
+		err := Observe(DefaultRunner[Runtime](), registering).Run(context.Background(), BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			return nil
+		})))
+		require.NoError(t, err)
+		require.Equal(t, []LifecycleState{StateBuilt, StateStarting, StateStopped}, rec.States())
+	})
+
+	t.Run("passes readiness on", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ctx, ready := NotifyReady(ctx)
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- Observe(DefaultRunner[Runtime]()).Run(ctx, BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+				Ready(ctx)
+				<-ctx.Done()
+				return nil
+			})))
+		}()
+
+		select {
+		case <-ready:
+		case <-time.After(5 * time.Second):
+			t.Fatal("readiness was not passed on")
+		}
+
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("publishes each state once when rebuilt", func(t *testing.T) {
+		var rec lifecycleRecorder
+
+		builds := 0
+		builder := BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			builds++
+			return RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			}), nil
+		})
+
+		runner := RunnerFunc[Runtime](func(ctx context.Context, b Builder[Runtime]) error {
+			for range 2 {
+				if _, err := b.Build(ctx); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+		err := Observe(runner, &rec).Run(context.Background(), builder)
+		require.NoError(t, err)
+		require.Equal(t, 2, builds)
+		require.Equal(t, []LifecycleState{StateBuilding, StateBuilt, StateStarting, StateStopped}, rec.States())
+	})
+
+	t.Run("is a no-op to register an observer without Observe", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			OnLifecycle(context.Background(), ObserverFunc(func(ctx context.Context, e LifecycleEvent) {}))
+		})
+	})
+}
+
+func TestLifecycleState_String(t *testing.T) {
+	require.Equal(t, "running", StateRunning.String())
+	require.Equal(t, "unknown", LifecycleState(-1).String())
+}
@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// Exit codes used by [Main] for each class of terminal error.
+const (
+	// ExitSuccess is used when the application returns without an error.
+	ExitSuccess = 0
+
+	// ExitRuntimeError is used when the Runtime returns an error.
+	ExitRuntimeError = 1
+
+	// ExitBuildError is used when the application fails to build, including
+	// configuration errors reported by --check-config.
+	ExitBuildError = 2
+
+	// ExitPanic is used when the application panics while being built or run.
+	ExitPanic = 3
+
+	// ExitShutdownTimeout is used when the application does not shut down within
+	// the shutdown timeout, or the shutdown is aborted by a second signal.
+	ExitShutdownTimeout = 4
+)
+
+// MainOption configures [Main].
+type MainOption func(*mainOptions)
+
+type mainOptions struct {
+	args            []string
+	signals         []os.Signal
+	shutdownTimeout time.Duration
+	logger          *slog.Logger
+	exit            func(int)
+}
+
+// MainArgs sets the command line arguments checked for the --check-config flag,
+// see [CheckConfigRequested]. The default is os.Args[1:].
+func MainArgs(args []string) MainOption {
+	return func(mo *mainOptions) {
+		mo.args = args
+	}
+}
+
+// MainSignals sets the signals which start shutting down the application.
+// The default is SIGINT and SIGTERM.
+func MainSignals(signals ...os.Signal) MainOption {
+	return func(mo *mainOptions) {
+		mo.signals = signals
+	}
+}
+
+// MainShutdownTimeout sets how long the application may take to shut down once a
+// signal is received, see [GracefulShutdown]. The default is 30 seconds.
+func MainShutdownTimeout(d time.Duration) MainOption {
+	return func(mo *mainOptions) {
+		mo.shutdownTimeout = d
+	}
+}
+
+// MainLogger sets the logger used to report the terminal error. The default logs
+// JSON to stderr.
+func MainLogger(logger *slog.Logger) MainOption {
+	return func(mo *mainOptions) {
+		mo.logger = logger
+	}
+}
+
+// MainExitFunc replaces os.Exit, which is mainly useful for testing.
+func MainExitFunc(exit func(code int)) MainOption {
+	return func(mo *mainOptions) {
+		mo.exit = exit
+	}
+}
+
+// Main is a standard entrypoint which builds and runs the application and then
+// exits the process with a code describing the outcome.
+//
+// The application is run by a [DefaultRunner] wrapped with [RecoverPanics] and
+// [GracefulShutdown], which shuts it down on SIGINT or SIGTERM. When the --check-config
+// flag is given, the application is checked with a [DryRunner] instead of being run.
+//
+// If the application fails, the error is logged and the process exits with
+// [ExitBuildError], [ExitRuntimeError], [ExitPanic] or [ExitShutdownTimeout]. Since
+// Runtimes commonly return ctx.Err() once stopped, errors wrapping [context.Canceled]
+// returned by the Runtime are treated as a clean exit.
+func Main[T Runtime](builder Builder[T], opts ...MainOption) {
+	mo := &mainOptions{
+		args:            os.Args[1:],
+		signals:         []os.Signal{os.Interrupt, syscall.SIGTERM},
+		shutdownTimeout: 30 * time.Second,
+		logger:          slog.New(slog.NewJSONHandler(os.Stderr, nil)),
+		exit:            os.Exit,
+	}
+	for _, opt := range opts {
+		opt(mo)
+	}
+
+	var built atomic.Bool
+	trackBuilt := BuilderFunc[T](func(ctx context.Context) (T, error) {
+		value, err := builder.Build(ctx)
+		built.Store(err == nil)
+		return value, err
+	})
+
+	checkConfig := CheckConfigRequested(mo.args)
+
+	var runner Runner[T]
+	if checkConfig {
+		runner = RecoverPanics(DryRunner[T]())
+	} else {
+		runner = GracefulShutdown(RecoverPanics(DefaultRunner[T]()), mo.shutdownTimeout, mo.signals...)
+	}
+
+	ctx := context.Background()
+	err := runner.Run(ctx, trackBuilt)
+
+	// A dry run only ever builds the application, so any error it reports is a build error.
+	code := exitCode(err, built.Load() && !checkConfig)
+	if code != ExitSuccess {
+		attrs := []any{slog.Any("error", err), slog.Int("exit_code", code)}
+
+		var panicErr *PanicError
+		if errors.As(err, &panicErr) {
+			attrs = append(attrs, slog.String("stack", string(panicErr.Stack)))
+		}
+		mo.logger.ErrorContext(ctx, "application failed", attrs...)
+	}
+	mo.exit(code)
+}
+
+// exitCode classifies the error returned by the Runner used by Main.
+func exitCode(err error, built bool) int {
+	var panicErr *PanicError
+	switch {
+	case err == nil:
+		return ExitSuccess
+	case errors.As(err, &panicErr):
+		return ExitPanic
+	case errors.Is(err, ErrShutdownTimeout), errors.Is(err, ErrShutdownAborted):
+		return ExitShutdownTimeout
+	case !built:
+		return ExitBuildError
+	case errors.Is(err, context.Canceled):
+		return ExitSuccess
+	default:
+		return ExitRuntimeError
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"log/slog"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/z5labs/bedrock/config"
+
+	"github.com/stretchr/testify/require"
+)
+
+func runMain(t *testing.T, builder Builder[Runtime], opts ...MainOption) (int, map[string]any) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	code := -1
+	opts = append([]MainOption{
+		MainArgs(nil),
+		MainLogger(slog.New(slog.NewJSONHandler(&buf, nil))),
+		MainExitFunc(func(c int) {
+			code = c
+		}),
+	}, opts...)
+
+	Main(builder, opts...)
+
+	if buf.Len() == 0 {
+		return code, nil
+	}
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	return code, record
+}
+
+func TestMain_ExitCodes(t *testing.T) {
+	testCases := []struct {
+		name    string
+		builder Builder[Runtime]
+		code    int
+	}{
+		{
+			name: "exits successfully when the runtime returns nil",
+			builder: BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			})),
+			code: ExitSuccess,
+		},
+		{
+			name: "treats context.Canceled as a clean exit",
+			builder: BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+				return context.Canceled
+			})),
+			code: ExitSuccess,
+		},
+		{
+			name: "exits with ExitBuildError when the build fails",
+			builder: BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+				return nil, errors.New("invalid config")
+			}),
+			code: ExitBuildError,
+		},
+		{
+			name: "exits with ExitRuntimeError when the runtime fails",
+			builder: BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+				return errors.New("failed")
+			})),
+			code: ExitRuntimeError,
+		},
+		{
+			name: "exits with ExitPanic when the runtime panics",
+			builder: BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+				panic("boom")
+			})),
+			code: ExitPanic,
+		},
+		{
+			name: "exits with ExitPanic when the build panics",
+			builder: BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+				panic("boom")
+			}),
+			code: ExitPanic,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			code, _ := runMain(t, tc.builder)
+			require.Equal(t, tc.code, code)
+		})
+	}
+}
+
+func TestMain_Logging(t *testing.T) {
+	t.Run("does not log a clean exit", func(t *testing.T) {
+		_, record := runMain(t, BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			return nil
+		})))
+		require.Nil(t, record)
+	})
+
+	t.Run("logs the terminal error", func(t *testing.T) {
+		_, record := runMain(t, BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			return errors.New("failed")
+		})))
+		require.Equal(t, "ERROR", record["level"])
+		require.Equal(t, "application failed", record["msg"])
+		require.Equal(t, "failed", record["error"])
+		require.Equal(t, float64(ExitRuntimeError), record["exit_code"])
+	})
+
+	t.Run("logs the stack of a panic", func(t *testing.T) {
+		_, record := runMain(t, BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			panic("boom")
+		})))
+		require.Equal(t, "recovered from panic: boom", record["error"])
+		require.Contains(t, record["stack"], "goroutine")
+	})
+}
+
+func TestMain_CheckConfig(t *testing.T) {
+	t.Run("checks the configuration instead of running", func(t *testing.T) {
+		builder := BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			_, err := ReadConfig(ctx, "port", config.ReaderOf(8080))
+			return RuntimeFunc(func(ctx context.Context) error {
+				t.Fatal("runtime should not be run")
+				return nil
+			}), err
+		})
+
+		code, _ := runMain(t, builder, MainArgs([]string{"--check-config"}))
+		require.Equal(t, ExitSuccess, code)
+	})
+
+	t.Run("is accepted by flags defined with config.NewFlags", func(t *testing.T) {
+		args := []string{"--check-config", "--port", "9090"}
+
+		builder := BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			fs := flag.NewFlagSet("app", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			flags := config.NewFlags(fs)
+			port := config.Flag[int](flags, "port", "port to listen on")
+
+			if err := flags.Parse(args); err != nil {
+				return nil, err
+			}
+
+			_, err := ReadConfig(ctx, "port", port)
+			return RuntimeFunc(func(ctx context.Context) error {
+				t.Fatal("runtime should not be run")
+				return nil
+			}), err
+		})
+
+		code, record := runMain(t, builder, MainArgs(args))
+		require.Equal(t, ExitSuccess, code, record)
+	})
+
+	t.Run("exits with ExitBuildError when the configuration is incomplete", func(t *testing.T) {
+		builder := BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			_, err := ReadConfig(ctx, "port", config.EmptyReader[int]())
+			return RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			}), err
+		})
+
+		code, record := runMain(t, builder, MainArgs([]string{"--check-config"}))
+		require.Equal(t, ExitBuildError, code)
+		require.Contains(t, record["error"], `config "port"`)
+	})
+}
+
+func TestMain_Shutdown(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("signals work differently on Windows")
+	}
+
+	t.Run("shuts down on signal", func(t *testing.T) {
+		go func() {
+			quiesce()
+			syscall.Kill(syscall.Getpid(), syscall.SIGUSR1)
+		}()
+
+		code, _ := runMain(t, BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})), MainSignals(syscall.SIGUSR1))
+		require.Equal(t, ExitSuccess, code)
+	})
+
+	t.Run("exits with ExitShutdownTimeout when the runtime does not stop in time", func(t *testing.T) {
+		stuck := make(chan struct{})
+		defer close(stuck)
+
+		go func() {
+			quiesce()
+			syscall.Kill(syscall.Getpid(), syscall.SIGUSR1)
+		}()
+
+		code, _ := runMain(t, BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			<-stuck
+			return nil
+		})), MainSignals(syscall.SIGUSR1), MainShutdownTimeout(10*time.Millisecond))
+		require.Equal(t, ExitShutdownTimeout, code)
+	})
+}
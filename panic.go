@@ -0,0 +1,29 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import "github.com/z5labs/bedrock/internal/panicerr"
+
+// PanicError is the error returned when bedrock recovers a panic, e.g. by
+// [RecoverPanics], [All], [Group] or the runtime packages.
+//
+// It carries the recovered Value, the Stack of the panicking goroutine and the
+// Goroutine, i.e. the function which started the panicking goroutine, which is
+// empty for the main goroutine. If Value is an error, it is returned by Unwrap.
+type PanicError = panicerr.Error
+
+// NewPanicError returns a *PanicError for value, which was returned by recover.
+// It must be called by the deferred function which recovered the panic so that
+// the stack trace includes where the panic occurred.
+//
+//	defer func() {
+//	    if r := recover(); r != nil {
+//	        err = bedrock.NewPanicError(r)
+//	    }
+//	}()
+func NewPanicError(value any) *PanicError {
+	return panicerr.New(value)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPanicError(t *testing.T) {
+	t.Run("unwraps error values", func(t *testing.T) {
+		cause := errors.New("cause")
+
+		var err error
+		func() {
+			defer func() {
+				err = NewPanicError(recover())
+			}()
+			panic(cause)
+		}()
+
+		require.ErrorIs(t, err, cause)
+		require.Equal(t, "recovered from panic: cause", err.Error())
+	})
+}
+
+func TestPanicError(t *testing.T) {
+	panicking := BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+		panic("boom")
+	}))
+
+	testCases := []struct {
+		name   string
+		runner Runner[Runtime]
+	}{
+		{
+			name:   "RecoverPanics",
+			runner: RecoverPanics(DefaultRunner[Runtime]()),
+		},
+		{
+			name:   "RecoverPanics wrapping GracefulShutdown",
+			runner: RecoverPanics(GracefulShutdown(DefaultRunner[Runtime](), time.Second)),
+		},
+		{
+			name: "Group",
+			runner: RunnerFunc[Runtime](func(ctx context.Context, b Builder[Runtime]) error {
+				rt, err := b.Build(ctx)
+				if err != nil {
+					return err
+				}
+				return Group(Member("worker", rt)).Run(ctx)
+			}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.runner.Run(context.Background(), panicking)
+
+			var panicErr *PanicError
+			require.ErrorAs(t, err, &panicErr)
+			require.Equal(t, "boom", panicErr.Value)
+			require.Contains(t, string(panicErr.Stack), "bedrock.TestPanicError")
+		})
+	}
+}
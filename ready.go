@@ -0,0 +1,51 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"sync"
+)
+
+// readyNotifier closes ch the first time a Runtime reports it is ready.
+type readyNotifier struct {
+	once sync.Once
+	ch   chan struct{}
+}
+
+func (n *readyNotifier) ready() {
+	n.once.Do(func() {
+		close(n.ch)
+	})
+}
+
+type readyNotifierKey struct{}
+
+// NotifyReady returns a copy of ctx along with a channel which is closed once the
+// Runtime run with the returned context reports that it is ready by calling [Ready].
+func NotifyReady(ctx context.Context) (context.Context, <-chan struct{}) {
+	n := &readyNotifier{ch: make(chan struct{})}
+	return context.WithValue(ctx, readyNotifierKey{}, n), n.ch
+}
+
+// Ready reports that the Runtime run with ctx has started and is ready to do work,
+// e.g. an HTTP server which is accepting connections. It is a no-op unless ctx was
+// derived from a context returned by [NotifyReady].
+//
+// Ready is safe to call multiple times and from multiple goroutines.
+func Ready(ctx context.Context) {
+	n, ok := ctx.Value(readyNotifierKey{}).(*readyNotifier)
+	if !ok {
+		return
+	}
+	n.ready()
+}
+
+// notifiesReady reports whether anything is waiting for the Runtime run with ctx to call Ready.
+func notifiesReady(ctx context.Context) bool {
+	_, ok := ctx.Value(readyNotifierKey{}).(*readyNotifier)
+	return ok
+}
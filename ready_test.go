@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReady(t *testing.T) {
+	t.Run("is a no-op without NotifyReady", func(t *testing.T) {
+		require.NotPanics(t, func() {
+			Ready(context.Background())
+		})
+	})
+
+	t.Run("closes the channel returned by NotifyReady", func(t *testing.T) {
+		ctx, ready := NotifyReady(context.Background())
+
+		Ready(ctx)
+		Ready(ctx)
+
+		select {
+		case <-ready:
+		default:
+			t.Fatal("expected ready channel to be closed")
+		}
+	})
+}
+
+func TestGroup_Ready(t *testing.T) {
+	t.Run("is ready once every member is ready or has returned", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		ctx, ready := NotifyReady(ctx)
+
+		apiReady := make(chan struct{})
+		rt := Group(
+			Member("api", RuntimeFunc(func(ctx context.Context) error {
+				<-apiReady
+				Ready(ctx)
+				<-ctx.Done()
+				return nil
+			})),
+			Member("migrate", RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			}), AllowExit()),
+		)
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- rt.Run(ctx)
+		}()
+
+		select {
+		case <-ready:
+			t.Fatal("group reported ready before every member")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		close(apiReady)
+		select {
+		case <-ready:
+		case <-time.After(5 * time.Second):
+			t.Fatal("group did not report ready")
+		}
+
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+}
@@ -0,0 +1,396 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+)
+
+// ErrReloadNotReady is reported when the Runtime built for a reload does not
+// report that it is ready, see [Ready], within the ready timeout.
+var ErrReloadNotReady = errors.New("reloaded runtime did not become ready")
+
+// ReloadOption configures a [HotReload] Runner.
+type ReloadOption func(*reloadOptions)
+
+type reloadOptions struct {
+	signals      []os.Signal
+	notify       []<-chan struct{}
+	readyTimeout time.Duration
+	onError      func(context.Context, error)
+}
+
+// ReloadOnSignal reloads the application whenever one of the given signals,
+// typically SIGHUP, is received.
+func ReloadOnSignal(signals ...os.Signal) ReloadOption {
+	return func(ro *reloadOptions) {
+		ro.signals = append(ro.signals, signals...)
+	}
+}
+
+// ReloadOnNotify reloads the application whenever a value is received from ch,
+// e.g. a change notification from a config source. Notifications received while
+// a reload is in progress are coalesced into a single reload.
+func ReloadOnNotify(ch <-chan struct{}) ReloadOption {
+	return func(ro *reloadOptions) {
+		ro.notify = append(ro.notify, ch)
+	}
+}
+
+// ReloadReadyTimeout sets how long to wait for a reloaded Runtime to report that
+// it is ready before rolling back. The default is 30 seconds.
+func ReloadReadyTimeout(d time.Duration) ReloadOption {
+	return func(ro *reloadOptions) {
+		ro.readyTimeout = d
+	}
+}
+
+// OnReloadError sets a function which is called whenever a reload is rolled back,
+// or the previous instance returns an error while being drained. By default,
+// these errors are discarded.
+func OnReloadError(f func(ctx context.Context, err error)) ReloadOption {
+	return func(ro *reloadOptions) {
+		ro.onError = f
+	}
+}
+
+// generation is a single build and run of the application by a HotReload Runner.
+type generation struct {
+	cancel context.CancelFunc
+	ready  <-chan struct{}
+	done   chan struct{}
+	err    error
+
+	// claims records the keys of the values shared with Carry by the generation.
+	claims *carryClaims
+}
+
+// stop cancels the generation and waits for it to return.
+func (g *generation) stop() error {
+	g.cancel()
+	<-g.done
+	return g.err
+}
+
+// HotReload wraps runner so the application can be rebuilt and swapped without
+// restarting the process.
+//
+// On each reload trigger, see [ReloadOnSignal] and [ReloadOnNotify], runner is used to
+// build and run a new instance of the application alongside the current one. Once the
+// new Runtime reports that it is ready, see [Ready], the context of the previous
+// instance is cancelled and it is drained. If the new instance fails to build, returns
+// or does not become ready within the ready timeout, it is stopped and the previous
+// instance keeps running; the error is reported to [OnReloadError].
+//
+// Components which must outlive a single instance, such as listening sockets, can be
+// shared between instances with [Carry]. Once a reload has been committed or rolled
+// back, the shared components which the current instance did not build again, e.g. the
+// listener for an address which is no longer configured, are released. The rest are
+// released once the last instance has returned. HotReload returns when ctx is done or
+// the current instance returns on its own.
+func HotReload[T Runtime](runner Runner[T], opts ...ReloadOption) Runner[T] {
+	ro := &reloadOptions{
+		readyTimeout: 30 * time.Second,
+		onError:      func(context.Context, error) {},
+	}
+	for _, opt := range opts {
+		opt(ro)
+	}
+
+	return RunnerFunc[T](func(ctx context.Context, builder Builder[T]) (err error) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		store := &carryStore{}
+		defer func() {
+			if rerr := store.release(context.WithoutCancel(ctx)); rerr != nil {
+				err = errors.Join(err, rerr)
+			}
+		}()
+
+		// releaseUnclaimed releases the carried values which g did not claim.
+		releaseUnclaimed := func(g *generation) {
+			if err := store.retain(context.WithoutCancel(ctx), g.claims); err != nil {
+				ro.onError(ctx, fmt.Errorf("releasing carried values: %w", err))
+			}
+		}
+
+		trigger := reloadTrigger(ctx, ro)
+
+		start := func() *generation {
+			claims := &carryClaims{}
+			genCtx, cancel := context.WithCancel(context.WithValue(ctx, carryStoreKey{}, carryScope{store: store, claims: claims}))
+			genCtx, ready := NotifyReady(genCtx)
+
+			g := &generation{
+				cancel: cancel,
+				ready:  ready,
+				done:   make(chan struct{}),
+				claims: claims,
+			}
+			go func() {
+				defer close(g.done)
+				defer func() {
+					if r := recover(); r != nil {
+						g.err = NewPanicError(r)
+					}
+				}()
+				g.err = runner.Run(genCtx, builder)
+			}()
+			go func() {
+				select {
+				case <-ready:
+					Ready(ctx)
+				case <-g.done:
+				}
+			}()
+			return g
+		}
+
+		current := start()
+		for {
+			select {
+			case <-ctx.Done():
+				return current.stop()
+			case <-current.done:
+				return current.err
+			case <-trigger:
+			}
+
+			next := start()
+			timer := time.NewTimer(ro.readyTimeout)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return errors.Join(current.stop(), next.stop())
+			case <-next.done:
+				timer.Stop()
+				if next.err == nil {
+					next.err = ErrUnexpectedExit
+				}
+				ro.onError(ctx, fmt.Errorf("reload rolled back: %w", next.err))
+				releaseUnclaimed(current)
+				continue
+			case <-timer.C:
+				ro.onError(ctx, fmt.Errorf("reload rolled back: %w", errors.Join(ErrReloadNotReady, next.stop())))
+				releaseUnclaimed(current)
+				continue
+			case <-next.ready:
+				timer.Stop()
+			}
+
+			previous := current
+			current = next
+			if err := previous.stop(); err != nil && !errors.Is(err, context.Canceled) {
+				ro.onError(ctx, fmt.Errorf("draining previous instance: %w", err))
+			}
+			releaseUnclaimed(current)
+		}
+	})
+}
+
+// reloadTrigger merges the configured signals and notification channels into a
+// single channel which holds at most one pending reload.
+func reloadTrigger(ctx context.Context, ro *reloadOptions) <-chan struct{} {
+	trigger := make(chan struct{}, 1)
+	fire := func() {
+		select {
+		case trigger <- struct{}{}:
+		default:
+		}
+	}
+
+	forward := func(ch <-chan struct{}) {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-ch:
+				if !ok {
+					return
+				}
+				fire()
+			}
+		}
+	}
+
+	if len(ro.signals) > 0 {
+		sigCh := make(chan os.Signal, 1)
+		signal.Notify(sigCh, ro.signals...)
+		context.AfterFunc(ctx, func() {
+			signal.Stop(sigCh)
+		})
+
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-sigCh:
+					fire()
+				}
+			}
+		}()
+	}
+	for _, ch := range ro.notify {
+		go forward(ch)
+	}
+	return trigger
+}
+
+// carryEntry holds a value shared between the instances of a HotReload Runner.
+type carryEntry struct {
+	mu    sync.Mutex
+	built bool
+	value any
+
+	// stack holds the release functions registered while building value.
+	stack cleanupStack
+}
+
+// release runs the release functions registered while building the value.
+func (e *carryEntry) release(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.built = false
+	e.value = nil
+	return e.stack.unwind(ctx)
+}
+
+// carryStore holds the values shared with [Carry] between the instances of a
+// HotReload Runner.
+type carryStore struct {
+	mu      sync.Mutex
+	entries map[string]*carryEntry
+	keys    []string // in the order the entries were created
+}
+
+func (s *carryStore) entry(key string) *carryEntry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.entries == nil {
+		s.entries = make(map[string]*carryEntry)
+	}
+	e, ok := s.entries[key]
+	if !ok {
+		e = &carryEntry{}
+		s.entries[key] = e
+		s.keys = append(s.keys, key)
+	}
+	return e
+}
+
+// retain releases every entry whose key was not claimed, in reverse creation order.
+func (s *carryStore) retain(ctx context.Context, claims *carryClaims) error {
+	s.mu.Lock()
+	var kept []string
+	var released []*carryEntry
+	for _, key := range s.keys {
+		if claims.has(key) {
+			kept = append(kept, key)
+			continue
+		}
+		released = append(released, s.entries[key])
+		delete(s.entries, key)
+	}
+	s.keys = kept
+	s.mu.Unlock()
+
+	errs := make([]error, 0, len(released))
+	for i := len(released) - 1; i >= 0; i-- {
+		errs = append(errs, released[i].release(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// release releases every entry, in reverse creation order.
+func (s *carryStore) release(ctx context.Context) error {
+	return s.retain(ctx, &carryClaims{})
+}
+
+// carryClaims records the keys of the values shared with Carry by a single
+// instance of a HotReload Runner.
+type carryClaims struct {
+	mu   sync.Mutex
+	keys map[string]bool
+}
+
+func (c *carryClaims) add(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.keys == nil {
+		c.keys = make(map[string]bool)
+	}
+	c.keys[key] = true
+}
+
+func (c *carryClaims) has(key string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.keys[key]
+}
+
+// carryScope is the context value through which an instance of a HotReload
+// Runner shares values with Carry.
+type carryScope struct {
+	store  *carryStore
+	claims *carryClaims
+}
+
+type carryStoreKey struct{}
+
+// Carry wraps a Builder so its value is shared between the instances of the
+// application run by a [HotReload] Runner, e.g. a listening socket which must
+// keep accepting connections while the application is reloaded.
+//
+// The first instance to build key builds the value with builder. Release
+// functions registered with [OnCleanup] by builder are deferred until the value
+// is released, i.e. once a reload has been committed, or rolled back, without
+// the current instance carrying key, or once the HotReload Runner returns.
+// Every instance, including the first, receives the result of calling share
+// with the value, which allows each instance to own, and close, its own handle
+// to the shared resource. If the build fails, the next instance tries again.
+//
+// Outside of a HotReload Runner, Carry returns the value built by builder as is.
+func Carry[T any](key string, builder Builder[T], share func(T) (T, error)) Builder[T] {
+	return BuilderFunc[T](func(ctx context.Context) (T, error) {
+		scope, ok := ctx.Value(carryStoreKey{}).(carryScope)
+		if !ok {
+			return builder.Build(ctx)
+		}
+
+		var zero T
+		e := scope.store.entry(key)
+		e.mu.Lock()
+		defer e.mu.Unlock()
+
+		if !e.built {
+			value, err := builder.Build(context.WithValue(ctx, cleanupStackKey{}, &e.stack))
+			if err != nil {
+				return zero, err
+			}
+			e.value = value
+			e.built = true
+		}
+
+		value, ok := e.value.(T)
+		if !ok {
+			return zero, fmt.Errorf("carried value %q has unexpected type %T", key, e.value)
+		}
+		scope.claims.add(key)
+		return share(value)
+	})
+}
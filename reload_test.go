@@ -0,0 +1,375 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// reloadRecorder builds Runtimes which report that they are ready and record
+// when each instance starts and stops.
+type reloadRecorder struct {
+	builds atomic.Int64
+
+	mu     sync.Mutex
+	events []string
+}
+
+func (r *reloadRecorder) record(event string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, event)
+}
+
+func (r *reloadRecorder) Events() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.events...)
+}
+
+func (r *reloadRecorder) Build(ctx context.Context) (Runtime, error) {
+	n := r.builds.Add(1)
+	return RuntimeFunc(func(ctx context.Context) error {
+		r.record(fmt.Sprintf("start %d", n))
+		Ready(ctx)
+		<-ctx.Done()
+		r.record(fmt.Sprintf("stop %d", n))
+		return nil
+	}), nil
+}
+
+func TestHotReload(t *testing.T) {
+	t.Run("starts the new instance before draining the old one", func(t *testing.T) {
+		rec := &reloadRecorder{}
+		reload := make(chan struct{})
+		runner := HotReload(DefaultRunner[Runtime](), ReloadOnNotify(reload))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- runner.Run(ctx, rec)
+		}()
+
+		require.Eventually(t, func() bool {
+			return len(rec.Events()) == 1
+		}, 5*time.Second, time.Millisecond)
+
+		reload <- struct{}{}
+		require.Eventually(t, func() bool {
+			return len(rec.Events()) == 3
+		}, 5*time.Second, time.Millisecond)
+
+		cancel()
+		require.NoError(t, <-errCh)
+		require.Equal(t, []string{"start 1", "start 2", "stop 1", "stop 2"}, rec.Events())
+	})
+
+	t.Run("reports readiness once the first instance is ready", func(t *testing.T) {
+		rec := &reloadRecorder{}
+		runner := HotReload(DefaultRunner[Runtime]())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		ctx, ready := NotifyReady(ctx)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- runner.Run(ctx, rec)
+		}()
+
+		select {
+		case <-ready:
+		case <-time.After(5 * time.Second):
+			t.Fatal("runner did not report that it is ready")
+		}
+
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("keeps the old instance running when the rebuild fails", func(t *testing.T) {
+		rec := &reloadRecorder{}
+		buildErr := errors.New("invalid config")
+		builder := BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			if rec.builds.Load() == 1 {
+				rec.builds.Add(1)
+				return nil, buildErr
+			}
+			return rec.Build(ctx)
+		})
+
+		reload := make(chan struct{})
+		reloadErrs := make(chan error, 1)
+		runner := HotReload(
+			DefaultRunner[Runtime](),
+			ReloadOnNotify(reload),
+			OnReloadError(func(ctx context.Context, err error) {
+				reloadErrs <- err
+			}),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- runner.Run(ctx, builder)
+		}()
+
+		require.Eventually(t, func() bool {
+			return len(rec.Events()) == 1
+		}, 5*time.Second, time.Millisecond)
+
+		reload <- struct{}{}
+		require.ErrorIs(t, <-reloadErrs, buildErr)
+
+		reload <- struct{}{}
+		require.Eventually(t, func() bool {
+			return len(rec.Events()) == 3
+		}, 5*time.Second, time.Millisecond)
+
+		cancel()
+		require.NoError(t, <-errCh)
+		require.Equal(t, []string{"start 1", "start 3", "stop 1", "stop 3"}, rec.Events())
+	})
+
+	t.Run("rolls back when the new instance does not become ready", func(t *testing.T) {
+		rec := &reloadRecorder{}
+		builder := BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			if rec.builds.Load() == 1 {
+				rec.builds.Add(1)
+				return RuntimeFunc(func(ctx context.Context) error {
+					<-ctx.Done()
+					return nil
+				}), nil
+			}
+			return rec.Build(ctx)
+		})
+
+		reload := make(chan struct{})
+		reloadErrs := make(chan error, 1)
+		runner := HotReload(
+			DefaultRunner[Runtime](),
+			ReloadOnNotify(reload),
+			ReloadReadyTimeout(10*time.Millisecond),
+			OnReloadError(func(ctx context.Context, err error) {
+				reloadErrs <- err
+			}),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- runner.Run(ctx, builder)
+		}()
+
+		require.Eventually(t, func() bool {
+			return len(rec.Events()) == 1
+		}, 5*time.Second, time.Millisecond)
+
+		reload <- struct{}{}
+		require.ErrorIs(t, <-reloadErrs, ErrReloadNotReady)
+
+		cancel()
+		require.NoError(t, <-errCh)
+		require.Equal(t, []string{"start 1", "stop 1"}, rec.Events())
+	})
+
+	t.Run("returns when the current instance returns", func(t *testing.T) {
+		runErr := errors.New("failed")
+		runner := HotReload(DefaultRunner[Runtime]())
+
+		err := runner.Run(context.Background(), BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			return runErr
+		})))
+		require.Equal(t, runErr, err)
+	})
+
+	t.Run("reloads on signal", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("signals work differently on Windows")
+		}
+
+		rec := &reloadRecorder{}
+		runner := HotReload(DefaultRunner[Runtime](), ReloadOnSignal(syscall.SIGHUP))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- runner.Run(ctx, rec)
+		}()
+
+		require.Eventually(t, func() bool {
+			return len(rec.Events()) == 1
+		}, 5*time.Second, time.Millisecond)
+
+		quiesce()
+		syscall.Kill(syscall.Getpid(), syscall.SIGHUP)
+
+		require.Eventually(t, func() bool {
+			return rec.builds.Load() == 2
+		}, signalWaitTimeout, time.Millisecond)
+
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+}
+
+func TestCarry(t *testing.T) {
+	t.Run("builds the value directly outside of HotReload", func(t *testing.T) {
+		var shares int
+		builder := Carry("key", BuilderOf(1), func(v int) (int, error) {
+			shares++
+			return v, nil
+		})
+
+		v, err := builder.Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, v)
+		require.Zero(t, shares)
+	})
+
+	t.Run("shares the value between instances", func(t *testing.T) {
+		var builds, shares, released atomic.Int64
+		shared := BuildWithCleanup(
+			BuilderFunc[int64](func(ctx context.Context) (int64, error) {
+				return builds.Add(1), nil
+			}),
+			func(ctx context.Context, v int64) error {
+				released.Add(1)
+				return nil
+			},
+		)
+		carried := Carry("key", shared, func(v int64) (int64, error) {
+			return v*100 + shares.Add(1), nil
+		})
+
+		values := make(chan int64, 2)
+		builder := Map(carried, func(ctx context.Context, v int64) (Runtime, error) {
+			return RuntimeFunc(func(ctx context.Context) error {
+				values <- v
+				Ready(ctx)
+				<-ctx.Done()
+				return nil
+			}), nil
+		})
+
+		reload := make(chan struct{})
+		runner := HotReload(DefaultRunner[Runtime](), ReloadOnNotify(reload))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- runner.Run(ctx, builder)
+		}()
+
+		require.Equal(t, int64(101), <-values)
+		reload <- struct{}{}
+		require.Equal(t, int64(102), <-values)
+		require.Zero(t, released.Load())
+
+		cancel()
+		require.NoError(t, <-errCh)
+		require.Equal(t, int64(1), builds.Load())
+		require.Equal(t, int64(1), released.Load())
+	})
+
+	t.Run("tries again after a failed build", func(t *testing.T) {
+		buildErr := errors.New("failed")
+		var builds atomic.Int64
+		carried := Carry("key", BuilderFunc[int64](func(ctx context.Context) (int64, error) {
+			if builds.Add(1) == 1 {
+				return 0, buildErr
+			}
+			return 1, nil
+		}), func(v int64) (int64, error) {
+			return v, nil
+		})
+
+		ctx := context.WithValue(context.Background(), carryStoreKey{}, carryScope{store: &carryStore{}, claims: &carryClaims{}})
+
+		_, err := carried.Build(ctx)
+		require.Equal(t, buildErr, err)
+
+		v, err := carried.Build(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), v)
+
+		v, err = carried.Build(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), v)
+		require.Equal(t, int64(2), builds.Load())
+	})
+	t.Run("releases values no longer carried after a reload", func(t *testing.T) {
+		var mu sync.Mutex
+		released := make(map[string]int)
+		carry := func(key string) Builder[string] {
+			shared := BuildWithCleanup(BuilderOf(key), func(ctx context.Context, key string) error {
+				mu.Lock()
+				defer mu.Unlock()
+				released[key]++
+				return nil
+			})
+			return Carry(key, shared, func(key string) (string, error) {
+				return key, nil
+			})
+		}
+		releasedCount := func(key string) int {
+			mu.Lock()
+			defer mu.Unlock()
+			return released[key]
+		}
+
+		var builds atomic.Int64
+		keys := make(chan string, 2)
+		builder := BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			key := "first"
+			if builds.Add(1) > 1 {
+				key = "second"
+			}
+			v, err := carry(key).Build(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return RuntimeFunc(func(ctx context.Context) error {
+				keys <- v
+				Ready(ctx)
+				<-ctx.Done()
+				return nil
+			}), nil
+		})
+
+		reload := make(chan struct{})
+		runner := HotReload(DefaultRunner[Runtime](), ReloadOnNotify(reload))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- runner.Run(ctx, builder)
+		}()
+
+		require.Equal(t, "first", <-keys)
+		reload <- struct{}{}
+		require.Equal(t, "second", <-keys)
+		require.Eventually(t, func() bool {
+			return releasedCount("first") == 1
+		}, 5*time.Second, time.Millisecond)
+		require.Zero(t, releasedCount("second"))
+
+		cancel()
+		require.NoError(t, <-errCh)
+		require.Equal(t, 1, releasedCount("first"))
+		require.Equal(t, 1, releasedCount("second"))
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// WriteReport writes a human readable startup report to w. Every recorded build
+// is listed beneath the build which depends on it, along with its duration and
+// outcome, followed by the total time from the first build starting to the last
+// one finishing.
+func (g *BuildGraph) WriteReport(w io.Writer) error {
+	records := g.Records()
+
+	children := make(map[int][]BuildRecord, len(records))
+	var first, last time.Time
+	for _, r := range records {
+		children[r.Parent] = append(children[r.Parent], r)
+
+		if first.IsZero() || r.Start.Before(first) {
+			first = r.Start
+		}
+		if end := r.Start.Add(r.Duration); end.After(last) {
+			last = end
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	// Keep the first error writing the report and skip writing the rest of it.
+	var err error
+	writeLine := func(s string) {
+		if err == nil {
+			_, err = io.WriteString(tw, s)
+		}
+	}
+	writeLine("COMPONENT\tDURATION\tRESULT\n")
+
+	var write func(parent, depth int)
+	write = func(parent, depth int) {
+		for _, r := range children[parent] {
+			result := "ok"
+			if r.Err != nil {
+				result = "error: " + strings.ReplaceAll(r.Err.Error(), "\n", "; ")
+			}
+			writeLine(strings.Repeat("  ", depth) + r.Name + "\t" + r.Duration.String() + "\t" + result + "\n")
+			write(r.ID, depth+1)
+		}
+	}
+	write(0, 0)
+
+	writeLine("total\t" + last.Sub(first).String() + "\t\n")
+	if err != nil {
+		return err
+	}
+	return tw.Flush()
+}
+
+// ReportStartup wraps runner to record the components built by the application in
+// a [BuildGraph] and pass it to report once the application has been built, whether
+// or not the build succeeded, and before the Runtime is run. The graph is also
+// available to the application with [BuildGraphFromContext].
+//
+// To print the report:
+//
+//	runner := bedrock.ReportStartup(bedrock.DefaultRunner[App](), func(ctx context.Context, g *bedrock.BuildGraph) {
+//	    g.WriteReport(os.Stderr)
+//	})
+func ReportStartup[T Runtime](runner Runner[T], report func(context.Context, *BuildGraph)) Runner[T] {
+	return RunnerFunc[T](func(ctx context.Context, builder Builder[T]) error {
+		ctx, g := WithBuildGraph(ctx)
+
+		return runner.Run(ctx, BuilderFunc[T](func(ctx context.Context) (T, error) {
+			value, err := builder.Build(ctx)
+			report(ctx, g)
+			return value, err
+		}))
+	})
+}
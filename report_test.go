@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildGraph_WriteReport(t *testing.T) {
+	t.Run("lists each build beneath its parent", func(t *testing.T) {
+		db := Named("db", BuilderFunc[string](func(ctx context.Context) (string, error) {
+			return "", errors.New("dial failed\nconnection refused")
+		}))
+		cache := Named("cache", BuilderOf("cache"))
+		app := Named("app", Bind(cache, func(ctx context.Context, cache string) Builder[string] {
+			return db
+		}))
+
+		ctx, g := WithBuildGraph(context.Background())
+		_, err := app.Build(ctx)
+		require.Error(t, err)
+
+		var buf bytes.Buffer
+		err = g.WriteReport(&buf)
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 5)
+		require.Regexp(t, `^COMPONENT\s+DURATION\s+RESULT$`, lines[0])
+		require.Regexp(t, `^app\s+\S+\s+error: dial failed; connection refused$`, lines[1])
+		require.Regexp(t, `^  cache\s+\S+\s+ok$`, lines[2])
+		require.Regexp(t, `^  db\s+\S+\s+error: dial failed; connection refused$`, lines[3])
+		require.Regexp(t, `^total\s+\S+`, lines[4])
+	})
+
+	t.Run("writes only the header and total without any builds", func(t *testing.T) {
+		_, g := WithBuildGraph(context.Background())
+
+		var buf bytes.Buffer
+		err := g.WriteReport(&buf)
+		require.NoError(t, err)
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 2)
+		require.Regexp(t, `^total\s+0s`, lines[1])
+	})
+
+	t.Run("returns errors writing the report", func(t *testing.T) {
+		ctx, g := WithBuildGraph(context.Background())
+		_, err := Named("app", BuilderOf("app")).Build(ctx)
+		require.NoError(t, err)
+
+		writeErr := errors.New("broken pipe")
+		err = g.WriteReport(failingWriter{err: writeErr})
+		require.ErrorIs(t, err, writeErr)
+	})
+}
+
+// failingWriter fails every write with err.
+type failingWriter struct {
+	err error
+}
+
+func (w failingWriter) Write(p []byte) (int, error) {
+	return 0, w.err
+}
+
+func TestReportStartup(t *testing.T) {
+	t.Run("reports the graph once the application is built", func(t *testing.T) {
+		var reported *BuildGraph
+		runner := ReportStartup(DefaultRunner[Runtime](), func(ctx context.Context, g *BuildGraph) {
+			reported = g
+		})
+
+		builder := Named("app", BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			require.NotNil(t, reported)
+
+			g, ok := BuildGraphFromContext(ctx)
+			require.True(t, ok)
+			require.Same(t, reported, g)
+			return nil
+		})))
+
+		err := runner.Run(context.Background(), builder)
+		require.NoError(t, err)
+		require.Equal(t, []string{"app"}, graphNames(reported.Nodes()))
+	})
+
+	t.Run("reports the graph when the build fails", func(t *testing.T) {
+		buildErr := errors.New("failed")
+
+		var reported *BuildGraph
+		runner := ReportStartup(DefaultRunner[Runtime](), func(ctx context.Context, g *BuildGraph) {
+			reported = g
+		})
+
+		err := runner.Run(context.Background(), Named("app", BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			return nil, buildErr
+		})))
+		require.Equal(t, buildErr, err)
+		require.NotNil(t, reported)
+
+		records := reported.Records()
+		require.Len(t, records, 1)
+		require.Equal(t, buildErr, records[0].Err)
+	})
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/z5labs/bedrock/config"
+)
+
+// RetryPolicy describes how a Builder is retried by [Retry]. Each setting is read
+// from its config.Reader every time the Builder is built, so it can be tuned without
+// redeploying. Settings which are nil or not set use their documented default.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// Default is 5.
+	MaxAttempts config.Reader[int]
+
+	// InitialBackoff is the wait before the second attempt, which doubles for every
+	// further attempt up to MaxBackoff. See [ExponentialBackoff] for how jitter is applied.
+	// Default is 100 milliseconds.
+	InitialBackoff config.Reader[time.Duration]
+
+	// MaxBackoff is the maximum wait between attempts.
+	// Default is 10 seconds.
+	MaxBackoff config.Reader[time.Duration]
+
+	// AttemptTimeout bounds how long a single attempt may take. The context passed
+	// to the Builder is cancelled once the attempt returns.
+	// Default is no timeout.
+	AttemptTimeout config.Reader[time.Duration]
+
+	// Retryable reports whether an attempt which failed with err should be retried.
+	// Default is to retry every error except a *BuildError caused by reading a
+	// configuration setting, since retrying would not change its value.
+	Retryable func(err error) bool
+}
+
+// defaultRetryable retries every error except configuration errors.
+func defaultRetryable(err error) bool {
+	var buildErr *BuildError
+	if errors.As(err, &buildErr) && buildErr.Key != "" {
+		return false
+	}
+	return true
+}
+
+type retrySettings struct {
+	maxAttempts    int
+	backoff        Backoff
+	attemptTimeout time.Duration
+	retryable      func(error) bool
+}
+
+func (p RetryPolicy) read(ctx context.Context) (retrySettings, error) {
+	var (
+		s   retrySettings
+		err error
+	)
+
+	s.maxAttempts, err = readOr(ctx, "MaxAttempts", 5, p.MaxAttempts)
+	if err != nil {
+		return s, err
+	}
+
+	initial, err := readOr(ctx, "InitialBackoff", 100*time.Millisecond, p.InitialBackoff)
+	if err != nil {
+		return s, err
+	}
+
+	limit, err := readOr(ctx, "MaxBackoff", 10*time.Second, p.MaxBackoff)
+	if err != nil {
+		return s, err
+	}
+	s.backoff = ExponentialBackoff(initial, limit)
+
+	s.attemptTimeout, err = readOr(ctx, "AttemptTimeout", 0, p.AttemptTimeout)
+	if err != nil {
+		return s, err
+	}
+
+	s.retryable = p.Retryable
+	if s.retryable == nil {
+		s.retryable = defaultRetryable
+	}
+	return s, nil
+}
+
+// readOr reads the setting named key from r, returning def if r is nil or
+// does not have a value set.
+func readOr[T any](ctx context.Context, key string, def T, r config.Reader[T]) (T, error) {
+	if r == nil {
+		return def, nil
+	}
+
+	return ReadConfig(ctx, key, config.Default(def, r))
+}
+
+// Retry returns a Builder which retries builder according to policy, e.g. while a
+// database or collector the application depends on is still starting.
+//
+// Retrying stops once an attempt succeeds, the error is not retryable, the maximum
+// number of attempts is reached or ctx is done. The error from the last attempt is
+// returned as is, joined with any error releasing it. Errors reading the policy are
+// returned as a *BuildError whose Path is "Retry". In dry run mode, see [DryRun],
+// builder is only built once.
+//
+// Each attempt is built in its own cleanup scope, see [OnCleanup], which is released
+// as soon as the attempt fails, so components built by a failed attempt, e.g. a bound
+// listener, do not conflict with the next attempt. The release functions registered
+// by the attempt which succeeds are released along with the rest of the application.
+func Retry[T any](builder Builder[T], policy RetryPolicy) Builder[T] {
+	return BuilderFunc[T](func(ctx context.Context) (T, error) {
+		s, err := policy.read(ctx)
+		if err != nil {
+			var zero T
+			return zero, WrapBuildError("Retry", err)
+		}
+		if DryRun(ctx) {
+			return builder.Build(ctx)
+		}
+
+		for attempt := 1; ; attempt++ {
+			value, err := buildAttempt(ctx, builder, s.attemptTimeout)
+			if err == nil {
+				return value, nil
+			}
+			if attempt >= s.maxAttempts || !s.retryable(err) {
+				return value, err
+			}
+
+			timer := time.NewTimer(s.backoff(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return value, err
+			case <-timer.C:
+			}
+		}
+	})
+}
+
+// buildAttempt builds builder in its own cleanup scope, which is released if the
+// attempt fails, so a failed attempt does not hold on to resources, e.g. a bound
+// port, the next attempt needs. Once the attempt succeeds, its release functions are
+// moved to the cleanup scope of ctx.
+func buildAttempt[T any](ctx context.Context, builder Builder[T], timeout time.Duration) (T, error) {
+	stack := &cleanupStack{}
+	attemptCtx := context.WithValue(ctx, cleanupStackKey{}, stack)
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		attemptCtx, cancel = context.WithTimeout(attemptCtx, timeout)
+		defer cancel()
+	}
+
+	value, err := builder.Build(attemptCtx)
+	if err != nil {
+		if rerr := stack.unwind(context.WithoutCancel(ctx)); rerr != nil {
+			err = errors.Join(err, rerr)
+		}
+		return value, err
+	}
+
+	stack.adopt(ctx)
+	return value, nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/z5labs/bedrock/config"
+
+	"github.com/stretchr/testify/require"
+)
+
+// failTimes returns a Builder which fails with err the first n times it is built.
+func failTimes(n int64, err error, calls *atomic.Int64) Builder[string] {
+	return BuilderFunc[string](func(ctx context.Context) (string, error) {
+		if calls.Add(1) <= n {
+			return "", err
+		}
+		return "connected", nil
+	})
+}
+
+// fastRetries is a RetryPolicy which does not wait between attempts.
+func fastRetries(maxAttempts int) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    config.ReaderOf(maxAttempts),
+		InitialBackoff: config.ReaderOf(time.Duration(0)),
+	}
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("returns the value once an attempt succeeds", func(t *testing.T) {
+		var calls atomic.Int64
+		b := Retry(failTimes(2, errors.New("connection refused"), &calls), fastRetries(5))
+
+		v, err := b.Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "connected", v)
+		require.Equal(t, int64(3), calls.Load())
+	})
+
+	t.Run("returns the last error once attempts are exhausted", func(t *testing.T) {
+		dialErr := errors.New("connection refused")
+
+		var calls atomic.Int64
+		b := Retry(failTimes(10, dialErr, &calls), fastRetries(3))
+
+		_, err := b.Build(context.Background())
+		require.Equal(t, dialErr, err)
+		require.Equal(t, int64(3), calls.Load())
+	})
+
+	t.Run("defaults to 5 attempts", func(t *testing.T) {
+		var calls atomic.Int64
+		b := Retry(failTimes(10, errors.New("connection refused"), &calls), RetryPolicy{
+			InitialBackoff: config.ReaderOf(time.Duration(0)),
+		})
+
+		_, err := b.Build(context.Background())
+		require.Error(t, err)
+		require.Equal(t, int64(5), calls.Load())
+	})
+
+	t.Run("does not retry configuration errors by default", func(t *testing.T) {
+		cfgErr := WrapBuildError("TCPListener", ConfigError("addr", config.ErrValueNotSet))
+
+		var calls atomic.Int64
+		b := Retry(failTimes(10, cfgErr, &calls), fastRetries(5))
+
+		_, err := b.Build(context.Background())
+		require.Equal(t, cfgErr, err)
+		require.Equal(t, int64(1), calls.Load())
+	})
+
+	t.Run("only retries errors accepted by Retryable", func(t *testing.T) {
+		permanent := errors.New("permission denied")
+
+		var calls atomic.Int64
+		policy := fastRetries(5)
+		policy.Retryable = func(err error) bool {
+			return !errors.Is(err, permanent)
+		}
+		b := Retry(failTimes(10, permanent, &calls), policy)
+
+		_, err := b.Build(context.Background())
+		require.Equal(t, permanent, err)
+		require.Equal(t, int64(1), calls.Load())
+	})
+
+	t.Run("bounds each attempt with AttemptTimeout", func(t *testing.T) {
+		var calls atomic.Int64
+		b := BuilderFunc[string](func(ctx context.Context) (string, error) {
+			if calls.Add(1) == 1 {
+				<-ctx.Done()
+				return "", ctx.Err()
+			}
+			return "connected", nil
+		})
+
+		policy := fastRetries(2)
+		policy.AttemptTimeout = config.ReaderOf(10 * time.Millisecond)
+
+		v, err := Retry(b, policy).Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "connected", v)
+	})
+
+	t.Run("stops waiting once the context is cancelled", func(t *testing.T) {
+		dialErr := errors.New("connection refused")
+		ctx, cancel := context.WithCancel(context.Background())
+
+		b := BuilderFunc[string](func(ctx context.Context) (string, error) {
+			cancel()
+			return "", dialErr
+		})
+
+		_, err := Retry(b, RetryPolicy{
+			InitialBackoff: config.ReaderOf(time.Hour),
+		}).Build(ctx)
+		require.Equal(t, dialErr, err)
+	})
+
+	t.Run("returns errors reading the policy", func(t *testing.T) {
+		readErr := errors.New("invalid integer")
+
+		var calls atomic.Int64
+		b := Retry(failTimes(0, nil, &calls), RetryPolicy{
+			MaxAttempts: config.ReaderFunc[int](func(ctx context.Context) (config.Value[int], error) {
+				return config.Value[int]{}, readErr
+			}),
+		})
+
+		_, err := b.Build(context.Background())
+		require.ErrorIs(t, err, readErr)
+
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"Retry"}, buildErr.Path)
+		require.Equal(t, "MaxAttempts", buildErr.Key)
+		require.Zero(t, calls.Load())
+	})
+
+	t.Run("releases the components built by a failed attempt", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := ln.Addr().String()
+		require.NoError(t, ln.Close())
+
+		var calls atomic.Int64
+		b := Retry(BuilderFunc[net.Listener](func(ctx context.Context) (net.Listener, error) {
+			ln, err := net.Listen("tcp", addr)
+			if err != nil {
+				return nil, err
+			}
+			OnCleanup(ctx, func(context.Context) error {
+				return ln.Close()
+			})
+
+			if calls.Add(1) == 1 {
+				return nil, errors.New("handler failed")
+			}
+			return ln, nil
+		}), fastRetries(2))
+
+		ctx, release := WithCleanup(context.Background())
+		_, err = b.Build(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), calls.Load())
+
+		_, err = net.Listen("tcp", addr)
+		require.Error(t, err, "the listener of the successful attempt should still be open")
+
+		err = release(context.Background())
+		require.NoError(t, err)
+
+		ln, err = net.Listen("tcp", addr)
+		require.NoError(t, err)
+		require.NoError(t, ln.Close())
+	})
+}
@@ -16,6 +16,9 @@
 //   - BuildTLSListener: Creates a bedrock.Builder that wraps a listener with TLS
 //   - Build: Creates a bedrock.Builder that constructs an HTTP server Runtime
 //
+// BuildReportHandler additionally provides a debug endpoint serving the startup
+// report recorded by bedrock.ReportStartup.
+//
 // # Basic Usage
 //
 // Create an HTTP server by composing a listener builder, handler builder, and server options:
@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/z5labs/bedrock"
+)
+
+// BuildReportHandler creates a bedrock.Builder for a debug endpoint which serves the
+// startup report of the application, as recorded by bedrock.ReportStartup or
+// bedrock.WithBuildGraph.
+//
+// The report is served as JSON by default. The format query parameter selects
+// another representation: "text" for the report written by BuildGraph.WriteReport,
+// or "dot" for a Graphviz graph. If the application was built without a
+// bedrock.BuildGraph, the handler responds with 404 Not Found.
+func BuildReportHandler() bedrock.Builder[http.Handler] {
+	return bedrock.BuilderFunc[http.Handler](func(ctx context.Context) (http.Handler, error) {
+		g, ok := bedrock.BuildGraphFromContext(ctx)
+		if !ok {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "startup report not recorded", http.StatusNotFound)
+			}), nil
+		}
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Query().Get("format") {
+			case "", "json":
+				w.Header().Set("Content-Type", "application/json")
+				json.NewEncoder(w).Encode(g) //nolint:errcheck
+			case "text":
+				w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+				g.WriteReport(w) //nolint:errcheck
+			case "dot":
+				w.Header().Set("Content-Type", "text/vnd.graphviz")
+				g.WriteDOT(w) //nolint:errcheck
+			default:
+				http.Error(w, "unknown format", http.StatusBadRequest)
+			}
+		}), nil
+	})
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildReportHandler(t *testing.T) {
+	ctx, _ := bedrock.WithBuildGraph(context.Background())
+	_, err := bedrock.Named("app", bedrock.BuilderOf(1)).Build(ctx)
+	require.NoError(t, err)
+
+	h, err := BuildReportHandler().Build(ctx)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		target      string
+		status      int
+		contentType string
+		body        string
+	}{
+		{
+			name:        "serves JSON by default",
+			target:      "/debug/startup",
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `"name":"app"`,
+		},
+		{
+			name:        "serves the text report",
+			target:      "/debug/startup?format=text",
+			status:      http.StatusOK,
+			contentType: "text/plain; charset=utf-8",
+			body:        "COMPONENT",
+		},
+		{
+			name:        "serves the DOT graph",
+			target:      "/debug/startup?format=dot",
+			status:      http.StatusOK,
+			contentType: "text/vnd.graphviz",
+			body:        "digraph bedrock {",
+		},
+		{
+			name:   "rejects unknown formats",
+			target: "/debug/startup?format=xml",
+			status: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tc.target, nil))
+
+			require.Equal(t, tc.status, w.Code)
+			if tc.contentType != "" {
+				require.Equal(t, tc.contentType, w.Header().Get("Content-Type"))
+			}
+			require.Contains(t, w.Body.String(), tc.body)
+		})
+	}
+
+	t.Run("serves valid JSON", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/startup", nil))
+
+		var out map[string]any
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &out))
+		require.Contains(t, out, "builds")
+	})
+
+	t.Run("responds with not found without a build graph", func(t *testing.T) {
+		h, err := BuildReportHandler().Build(context.Background())
+		require.NoError(t, err)
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/startup", nil))
+		require.Equal(t, http.StatusNotFound, w.Code)
+	})
+}
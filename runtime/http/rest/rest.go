@@ -13,6 +13,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/swaggest/openapi-go/openapi3"
 	"github.com/z5labs/bedrock"
+	"github.com/z5labs/bedrock/internal/otelpanic"
 )
 
 // Option configures the API builder.
@@ -205,7 +206,13 @@ func buildRouteHandler(route Route) http.Handler {
 		}
 
 		// Step 3: Call handler.
-		resp, err := ep.handler(r.Context(), store, body)
+		resp, err := callHandler(r.Context(), ep, store, body)
+		if otelpanic.Record(r.Context(), err) {
+			// Panics are never passed to the error encoders, since the
+			// recovered value could expose internal details to the client.
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
 		if err != nil {
 			// Step 4: Try error encoders in order.
 			for _, enc := range ep.errEncoders {
@@ -231,6 +238,25 @@ func buildRouteHandler(route Route) http.Handler {
 	})
 }
 
+// callHandler calls the endpoint handler, returning a panic as a *bedrock.PanicError.
+// Recovered panics are recorded on the active span and log pipeline, the same as by
+// otel.RecordPanic, and answered with a generic 500 Internal Server Error rather than
+// being encoded by the route's error encoders.
+func callHandler(ctx context.Context, ep Endpoint, store paramStore, body any) (resp any, err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		if r == http.ErrAbortHandler {
+			panic(r)
+		}
+		err = bedrock.NewPanicError(r)
+	}()
+
+	return ep.handler(ctx, store, body)
+}
+
 func writeValidationError(w http.ResponseWriter, err error) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusBadRequest)
@@ -347,6 +347,24 @@ func TestCatchAll_HandlesUnmatchedErrors(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
 
+func TestCatchAll_HandlesPanics(t *testing.T) {
+	ep := GET("/panic", func(ctx context.Context, req Request[EmptyBody]) (User, error) {
+		panic("boom")
+	})
+	ep = WriteJSON[User](200, ep)
+	route := CatchAll[GenericError](500, wrapGenericError, ep)
+
+	h := buildAndServe(t, route)
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/panic", nil)
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "Internal Server Error\n", w.Body.String())
+	assert.NotContains(t, w.Body.String(), "boom")
+}
+
 func TestPathParam_RequiredValidation(t *testing.T) {
 	var userID = PathParam[string]("id", MinLength(3), MaxLength(10))
 
@@ -19,25 +19,101 @@ import (
 )
 
 // BuildTCPListener creates a bedrock.Builder that constructs a TCP listener.
+//
+// The listener is registered with bedrock.OnCleanup so it is closed if a later
+// build step fails or once the Runtime returns.
+//
+// When run by a bedrock.HotReload Runner, the listening socket is carried over
+// between reloads with bedrock.Carry so no connections are refused while the
+// application is swapped. Each instance receives its own duplicate of the socket.
+// If a reload changes the address, the socket bound to the previous address is
+// closed once the reload has been committed.
 func BuildTCPListener(addr config.Reader[*net.TCPAddr]) bedrock.Builder[*net.TCPListener] {
-	return bedrock.BuilderFunc[*net.TCPListener](func(ctx context.Context) (*net.TCPListener, error) {
-		tcpAddr := config.Must(ctx, addr)
-		ln, err := net.ListenTCP("tcp", tcpAddr)
+	return bedrock.Named("TCPListener", bedrock.BuilderFunc[*net.TCPListener](func(ctx context.Context) (*net.TCPListener, error) {
+		tcpAddr, err := bedrock.ReadConfig(ctx, "addr", addr)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("TCPListener", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		listen := bedrock.BuildWithCleanup(
+			bedrock.BuilderFunc[*net.TCPListener](func(ctx context.Context) (*net.TCPListener, error) {
+				return net.ListenTCP("tcp", tcpAddr)
+			}),
+			func(_ context.Context, ln *net.TCPListener) error {
+				return closeListener(ln)
+			},
+		)
+
+		// Each instance of a HotReload Runner closes its own duplicate of the
+		// carried socket, which is only closed itself once the Runner returns.
+		share := func(ln *net.TCPListener) (*net.TCPListener, error) {
+			dup, err := dupTCPListener(ln)
+			if err != nil {
+				return nil, err
+			}
+			bedrock.OnCleanup(ctx, func(context.Context) error {
+				return closeListener(dup)
+			})
+			return dup, nil
+		}
+
+		ln, err := bedrock.Carry("TCPListener "+tcpAddr.String(), listen, share).Build(ctx)
 		if err != nil {
-			return nil, err
+			return nil, bedrock.WrapBuildError("TCPListener", err)
 		}
 		return ln, nil
-	})
+	}))
+}
+
+// dupTCPListener returns a new listener for the socket of ln which may be
+// closed independently of ln.
+func dupTCPListener(ln *net.TCPListener) (*net.TCPListener, error) {
+	f, err := ln.File()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	dup, err := net.FileListener(f)
+	if err != nil {
+		return nil, err
+	}
+	return dup.(*net.TCPListener), nil
+}
+
+// closeListener closes ln, ignoring the error returned when the listener
+// has already been closed by http.Server.Shutdown.
+func closeListener(ln net.Listener) error {
+	err := ln.Close()
+	if err == nil || errors.Is(err, net.ErrClosed) {
+		return nil
+	}
+	return err
 }
 
 // BuildTLSListener creates a bedrock.Builder that wraps a base listener with TLS.
+//
+// The TLS configuration is read as a config.Secret, so it is redacted from any config.Report.
 func BuildTLSListener[T net.Listener](
 	base bedrock.Builder[T],
 	tlsConfig config.Reader[*tls.Config],
 ) bedrock.Builder[net.Listener] {
-	return bedrock.Map(base, func(ctx context.Context, baseListener T) (net.Listener, error) {
-		return tls.NewListener(baseListener, config.Must(ctx, tlsConfig)), nil
-	})
+	return bedrock.Named("TLSListener", bedrock.BuilderFunc[net.Listener](func(ctx context.Context) (net.Listener, error) {
+		baseListener, err := base.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("TLSListener", err)
+		}
+
+		cfg, err := bedrock.ReadConfig(ctx, "tlsConfig", config.Secret(tlsConfig))
+		if err != nil {
+			return nil, bedrock.WrapBuildError("TLSListener", err)
+		}
+
+		return tls.NewListener(baseListener, cfg), nil
+	}))
 }
 
 // Server holds the configuration for an HTTP server.
@@ -111,18 +187,51 @@ type Runtime struct {
 	srv *http.Server
 }
 
+// Addr returns the address the server listens on, e.g. to find the port chosen
+// for a listener bound to port 0. It returns nil for a Runtime built in dry run mode.
+func (r Runtime) Addr() net.Addr {
+	if r.ls == nil {
+		return nil
+	}
+	return r.ls.Addr()
+}
+
 // Run starts the HTTP server and blocks until the context is cancelled or an error occurs.
-// When the context is cancelled, the server performs a graceful shutdown.
+// When the context is cancelled, the server performs a graceful shutdown bounded by
+// bedrock.ShutdownContext, after which any remaining connections are closed.
 // Returns nil if the server shuts down cleanly, or an error if the server fails to start or serve.
+//
+// Run reports that it is ready, see bedrock.Ready, once it starts serving, which a
+// bedrock.Observe Runner publishes as bedrock.StateRunning.
 func (r Runtime) Run(ctx context.Context) error {
 	err := fixedpool.Wait(
 		ctx,
 		func(ctx context.Context) error {
-			return r.srv.Serve(r.ls)
+			// The listener is already bound, so connections are queued until Serve accepts them.
+			bedrock.Ready(ctx)
+
+			err := r.srv.Serve(r.ls)
+			if errors.Is(err, http.ErrServerClosed) {
+				return nil
+			}
+			return err
 		},
 		func(ctx context.Context) error {
 			<-ctx.Done()
-			return r.srv.Shutdown(context.Background())
+
+			shutdownCtx, cancel := bedrock.ShutdownContext(ctx)
+			defer cancel()
+
+			err := r.srv.Shutdown(shutdownCtx)
+			if err == nil {
+				return nil
+			}
+			if cause := context.Cause(shutdownCtx); cause != nil {
+				err = cause
+			}
+
+			// Forcibly close any connections which did not finish in time.
+			return errors.Join(err, r.srv.Close())
 		},
 	)
 
@@ -139,11 +248,25 @@ func (r Runtime) Run(ctx context.Context) error {
 //
 // The builder applies the Server configuration to create an http.Server with the
 // provided handler. If configuration values are not set, defaults are applied as
-// documented in NewServer.
+// documented on each ServerOption.
+//
+// The listener and handler are built concurrently. Errors from building them, or from
+// reading a server setting, are returned as a *bedrock.BuildError.
 func Build(listener bedrock.Builder[net.Listener], b bedrock.Builder[http.Handler], opts ...ServerOption) bedrock.Builder[Runtime] {
-	return bedrock.BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
-		ln := bedrock.MustBuild(ctx, listener)
-		h := bedrock.MustBuild(ctx, b)
+	type components struct {
+		ln net.Listener
+		h  http.Handler
+	}
+
+	listenerAndHandler := bedrock.Zip2(listener, b, func(_ context.Context, ln net.Listener, h http.Handler) (components, error) {
+		return components{ln: ln, h: h}, nil
+	})
+
+	return bedrock.Named("http.Runtime", bedrock.BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+		c, err := listenerAndHandler.Build(ctx)
+		if err != nil {
+			return Runtime{}, bedrock.WrapBuildError("http.Runtime", err)
+		}
 
 		srv := Server{}
 		for _, opt := range opts {
@@ -151,20 +274,54 @@ func Build(listener bedrock.Builder[net.Listener], b bedrock.Builder[http.Handle
 		}
 
 		httpServer := &http.Server{
-			Handler:                      h,
-			DisableGeneralOptionsHandler: config.MustOr(ctx, false, srv.disableGeneralOptionsHandler),
-			ReadTimeout:                  config.MustOr(ctx, 5*time.Second, srv.readTimeout),
-			ReadHeaderTimeout:            config.MustOr(ctx, 2*time.Second, srv.readHeaderTimeout),
-			WriteTimeout:                 config.MustOr(ctx, 10*time.Second, srv.writeTimeout),
-			IdleTimeout:                  config.MustOr(ctx, 120*time.Second, srv.idleTimeout),
-			MaxHeaderBytes:               config.MustOr(ctx, 1048576, srv.maxHeaderBytes),
+			Handler: c.h,
+		}
+
+		httpServer.DisableGeneralOptionsHandler, err = readOr(ctx, "DisableGeneralOptionsHandler", false, srv.disableGeneralOptionsHandler)
+		if err != nil {
+			return Runtime{}, bedrock.WrapBuildError("http.Runtime", err)
+		}
+
+		httpServer.ReadTimeout, err = readOr(ctx, "ReadTimeout", 5*time.Second, srv.readTimeout)
+		if err != nil {
+			return Runtime{}, bedrock.WrapBuildError("http.Runtime", err)
+		}
+
+		httpServer.ReadHeaderTimeout, err = readOr(ctx, "ReadHeaderTimeout", 2*time.Second, srv.readHeaderTimeout)
+		if err != nil {
+			return Runtime{}, bedrock.WrapBuildError("http.Runtime", err)
+		}
+
+		httpServer.WriteTimeout, err = readOr(ctx, "WriteTimeout", 10*time.Second, srv.writeTimeout)
+		if err != nil {
+			return Runtime{}, bedrock.WrapBuildError("http.Runtime", err)
+		}
+
+		httpServer.IdleTimeout, err = readOr(ctx, "IdleTimeout", 120*time.Second, srv.idleTimeout)
+		if err != nil {
+			return Runtime{}, bedrock.WrapBuildError("http.Runtime", err)
+		}
+
+		httpServer.MaxHeaderBytes, err = readOr(ctx, "MaxHeaderBytes", 1048576, srv.maxHeaderBytes)
+		if err != nil {
+			return Runtime{}, bedrock.WrapBuildError("http.Runtime", err)
 		}
 
 		rt := Runtime{
-			ls:  ln,
+			ls:  c.ln,
 			srv: httpServer,
 		}
 
 		return rt, nil
-	})
+	}))
+}
+
+// readOr reads the server setting named key from r, returning def if r is nil
+// or does not have a value set.
+func readOr[T any](ctx context.Context, key string, def T, r config.Reader[T]) (T, error) {
+	if r == nil {
+		return def, nil
+	}
+
+	return bedrock.ReadConfig(ctx, key, config.Default(def, r))
 }
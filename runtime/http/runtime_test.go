@@ -13,6 +13,9 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"io"
 	"math/big"
 	"net"
 	"net/http"
@@ -21,6 +24,7 @@ import (
 	"time"
 
 	"github.com/z5labs/bedrock"
+	"github.com/z5labs/bedrock/bedrocktest"
 	"github.com/z5labs/bedrock/config"
 
 	"github.com/stretchr/testify/require"
@@ -111,6 +115,57 @@ func TestBuildTCPListener(t *testing.T) {
 	}
 }
 
+func TestBuildTCPListener_Cleanup(t *testing.T) {
+	t.Run("closes listener when a later build step fails", func(t *testing.T) {
+		var ln *net.TCPListener
+		listenerBuilder := bedrock.Map(BuildTCPListener(config.ReaderOf(&net.TCPAddr{Port: 0})), func(ctx context.Context, tcpLn *net.TCPListener) (net.Listener, error) {
+			ln = tcpLn
+			return tcpLn, nil
+		})
+
+		handlerErr := errors.New("handler failed")
+		handlerBuilder := bedrock.BuilderFunc[http.Handler](func(ctx context.Context) (http.Handler, error) {
+			return nil, handlerErr
+		})
+
+		runtimeBuilder := bedrock.Bind(listenerBuilder, func(ctx context.Context, l net.Listener) bedrock.Builder[Runtime] {
+			return Build(bedrock.BuilderOf(l), handlerBuilder)
+		})
+
+		err := bedrock.DefaultRunner[Runtime]().Run(context.Background(), runtimeBuilder)
+		require.ErrorIs(t, err, handlerErr)
+		require.NotNil(t, ln)
+
+		_, err = ln.Accept()
+		require.ErrorIs(t, err, net.ErrClosed)
+	})
+
+	t.Run("does not report an error when the server already closed the listener", func(t *testing.T) {
+		listenerBuilder := bedrock.Map(BuildTCPListener(config.ReaderOf(&net.TCPAddr{Port: 0})), func(ctx context.Context, ln *net.TCPListener) (net.Listener, error) {
+			return ln, nil
+		})
+
+		handlerBuilder := bedrock.BuilderFunc[http.Handler](func(ctx context.Context) (http.Handler, error) {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), nil
+		})
+
+		runtimeBuilder := Build(listenerBuilder, handlerBuilder,
+			DisableGeneralOptionsHandler(config.ReaderOf(false)),
+			ReadTimeout(config.ReaderOf(5*time.Second)),
+			ReadHeaderTimeout(config.ReaderOf(2*time.Second)),
+			WriteTimeout(config.ReaderOf(10*time.Second)),
+			IdleTimeout(config.ReaderOf(120*time.Second)),
+			MaxHeaderBytes(config.ReaderOf(1048576)),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := bedrock.DefaultRunner[Runtime]().Run(ctx, runtimeBuilder)
+		require.NoError(t, err)
+	})
+}
+
 func TestBuild(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -233,6 +288,26 @@ func TestBuild(t *testing.T) {
 				rt.ls.Close()
 			},
 		},
+		{
+			name: "applies defaults when options are not provided",
+			listener: bedrock.Map(BuildTCPListener(config.ReaderOf(&net.TCPAddr{Port: 0})), func(ctx context.Context, ln *net.TCPListener) (net.Listener, error) {
+				return ln, nil
+			}),
+			handlerFunc: func() bedrock.Builder[http.Handler] {
+				return bedrock.BuilderFunc[http.Handler](func(ctx context.Context) (http.Handler, error) {
+					return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), nil
+				})
+			},
+			verifyServer: func(t *testing.T, rt Runtime) {
+				require.False(t, rt.srv.DisableGeneralOptionsHandler)
+				require.Equal(t, 5*time.Second, rt.srv.ReadTimeout)
+				require.Equal(t, 2*time.Second, rt.srv.ReadHeaderTimeout)
+				require.Equal(t, 10*time.Second, rt.srv.WriteTimeout)
+				require.Equal(t, 120*time.Second, rt.srv.IdleTimeout)
+				require.Equal(t, 1048576, rt.srv.MaxHeaderBytes)
+				rt.ls.Close()
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -256,6 +331,215 @@ func TestBuild(t *testing.T) {
 	}
 }
 
+func TestBuild_Errors(t *testing.T) {
+	okHandler := bedrock.BuilderFunc[http.Handler](func(ctx context.Context) (http.Handler, error) {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), nil
+	})
+
+	t.Run("records the listener setting which is not set", func(t *testing.T) {
+		listenerBuilder := bedrock.Map(BuildTCPListener(config.EmptyReader[*net.TCPAddr]()), func(ctx context.Context, ln *net.TCPListener) (net.Listener, error) {
+			return ln, nil
+		})
+
+		_, err := Build(listenerBuilder, okHandler).Build(context.Background())
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"http.Runtime", "TCPListener"}, buildErr.Path)
+		require.Equal(t, "addr", buildErr.Key)
+	})
+
+	t.Run("records the server setting which could not be read", func(t *testing.T) {
+		ln, err := net.Listen("tcp", ":0")
+		require.NoError(t, err)
+		defer ln.Close()
+
+		readErr := errors.New("invalid duration")
+		readTimeout := config.ReaderFunc[time.Duration](func(ctx context.Context) (config.Value[time.Duration], error) {
+			return config.Value[time.Duration]{}, readErr
+		})
+
+		_, err = Build(bedrock.BuilderOf(ln), okHandler, ReadTimeout(readTimeout)).Build(context.Background())
+		require.ErrorIs(t, err, readErr)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"http.Runtime"}, buildErr.Path)
+		require.Equal(t, "ReadTimeout", buildErr.Key)
+	})
+
+	t.Run("returns handler build errors", func(t *testing.T) {
+		ln, err := net.Listen("tcp", ":0")
+		require.NoError(t, err)
+		defer ln.Close()
+
+		handlerErr := errors.New("handler failed")
+		handlerBuilder := bedrock.BuilderFunc[http.Handler](func(ctx context.Context) (http.Handler, error) {
+			return nil, handlerErr
+		})
+
+		_, err = Build(bedrock.BuilderOf(ln), handlerBuilder).Build(context.Background())
+		require.ErrorIs(t, err, handlerErr)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"http.Runtime"}, buildErr.Path)
+		require.Empty(t, buildErr.Key)
+	})
+}
+
+func TestBuild_DryRun(t *testing.T) {
+	t.Run("collects every config error without binding the listener", func(t *testing.T) {
+		listenerBuilder := bedrock.Map(BuildTCPListener(config.ReaderOf(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})), func(ctx context.Context, ln *net.TCPListener) (net.Listener, error) {
+			require.Nil(t, ln)
+			return ln, nil
+		})
+		handlerBuilder := bedrock.BuilderFunc[http.Handler](func(ctx context.Context) (http.Handler, error) {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), nil
+		})
+
+		readErr := errors.New("invalid duration")
+		readTimeout := config.ReaderFunc[time.Duration](func(ctx context.Context) (config.Value[time.Duration], error) {
+			return config.Value[time.Duration]{}, readErr
+		})
+		maxHeaderBytes := config.ReaderFunc[int](func(ctx context.Context) (config.Value[int], error) {
+			return config.Value[int]{}, readErr
+		})
+
+		err := bedrock.DryRunner[Runtime]().Run(context.Background(), Build(listenerBuilder, handlerBuilder,
+			ReadTimeout(readTimeout),
+			MaxHeaderBytes(maxHeaderBytes),
+		))
+		require.ErrorIs(t, err, readErr)
+
+		joined, ok := err.(interface{ Unwrap() []error })
+		require.True(t, ok)
+
+		var keys []string
+		for _, e := range joined.Unwrap() {
+			var buildErr *bedrock.BuildError
+			require.ErrorAs(t, e, &buildErr)
+			require.Equal(t, []string{"http.Runtime"}, buildErr.Path)
+			keys = append(keys, buildErr.Key)
+		}
+		require.Equal(t, []string{"ReadTimeout", "MaxHeaderBytes"}, keys)
+	})
+
+	t.Run("records the listener setting which is not set", func(t *testing.T) {
+		listenerBuilder := bedrock.Map(BuildTCPListener(config.EmptyReader[*net.TCPAddr]()), func(ctx context.Context, ln *net.TCPListener) (net.Listener, error) {
+			return ln, nil
+		})
+		handlerBuilder := bedrock.BuilderFunc[http.Handler](func(ctx context.Context) (http.Handler, error) {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}), nil
+		})
+
+		err := bedrock.DryRunner[Runtime]().Run(context.Background(), Build(listenerBuilder, handlerBuilder))
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"http.Runtime", "TCPListener"}, buildErr.Path)
+		require.Equal(t, "addr", buildErr.Key)
+	})
+}
+
+func TestBuild_Graph(t *testing.T) {
+	t.Run("records the listener as a dependency of the runtime", func(t *testing.T) {
+		addr := config.ReaderOf(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		listenerBuilder := bedrock.Map(BuildTCPListener(addr), func(ctx context.Context, ln *net.TCPListener) (net.Listener, error) {
+			return ln, nil
+		})
+		handlerBuilder := bedrock.BuilderOf[http.Handler](http.NotFoundHandler())
+
+		ctx, release := bedrock.WithCleanup(context.Background())
+		defer release(ctx)
+
+		ctx, g := bedrock.WithBuildGraph(ctx)
+		_, err := Build(listenerBuilder, handlerBuilder).Build(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, []bedrock.GraphEdge{
+			{From: "http.Runtime", To: "TCPListener"},
+		}, g.Edges())
+	})
+}
+
+func TestBuild_HotReload(t *testing.T) {
+	t.Run("keeps serving on the same socket while reloading", func(t *testing.T) {
+		var addr atomic.Value
+		listenerBuilder := bedrock.Map(BuildTCPListener(config.ReaderOf(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})), func(ctx context.Context, ln *net.TCPListener) (net.Listener, error) {
+			addr.Store(ln.Addr().String())
+			return ln, nil
+		})
+
+		var builds atomic.Int64
+		handlerBuilder := bedrock.BuilderFunc[http.Handler](func(ctx context.Context) (http.Handler, error) {
+			n := builds.Add(1)
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, n)
+			}), nil
+		})
+
+		reload := make(chan struct{})
+		runner := bedrock.HotReload(bedrock.DefaultRunner[Runtime](), bedrock.ReloadOnNotify(reload))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		ctx, ready := bedrock.NotifyReady(ctx)
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- runner.Run(ctx, Build(listenerBuilder, handlerBuilder))
+		}()
+
+		select {
+		case <-ready:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for server to be ready")
+		}
+
+		client := &http.Client{
+			Transport: &http.Transport{DisableKeepAlives: true},
+		}
+		get := func() (string, error) {
+			resp, err := client.Get("http://" + addr.Load().(string))
+			if err != nil {
+				return "", err
+			}
+			defer resp.Body.Close()
+
+			b, err := io.ReadAll(resp.Body)
+			return string(b), err
+		}
+
+		body, err := get()
+		require.NoError(t, err)
+		require.Equal(t, "1", body)
+		firstAddr := addr.Load()
+
+		reload <- struct{}{}
+		for {
+			body, err := get()
+			require.NoError(t, err)
+			if body == "2" {
+				break
+			}
+			require.Equal(t, "1", body)
+		}
+		require.Equal(t, firstAddr, addr.Load())
+
+		cancel()
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for shutdown")
+		}
+
+		_, err = get()
+		require.Error(t, err)
+	})
+}
+
 func TestBuildTLSListener(t *testing.T) {
 	t.Run("wraps listener with TLS config", func(t *testing.T) {
 		baseListener := BuildTCPListener(config.ReaderOf(&net.TCPAddr{Port: 0}))
@@ -272,6 +556,41 @@ func TestBuildTLSListener(t *testing.T) {
 
 		ln.Close()
 	})
+
+	t.Run("records the TLS config setting which is not set", func(t *testing.T) {
+		baseListener := BuildTCPListener(config.ReaderOf(&net.TCPAddr{Port: 0}))
+
+		tlsListenerBuilder := BuildTLSListener(baseListener, config.EmptyReader[*tls.Config]())
+
+		ctx, release := bedrock.WithCleanup(context.Background())
+		defer release(context.Background())
+
+		_, err := tlsListenerBuilder.Build(ctx)
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"TLSListener"}, buildErr.Path)
+		require.Equal(t, "tlsConfig", buildErr.Key)
+	})
+
+	t.Run("redacts the TLS config from the config report", func(t *testing.T) {
+		baseListener := BuildTCPListener(config.ReaderOf(&net.TCPAddr{Port: 0}))
+		tlsConfig := config.ReaderFunc[*tls.Config](func(ctx context.Context) (config.Value[*tls.Config], error) {
+			return config.ValueOf(createTestTLSConfig(t)).WithSource(config.Source{Kind: config.SourceFile, File: "tls.pem"}), nil
+		})
+
+		ctx, report := config.WithReport(context.Background())
+		ctx, release := bedrock.WithCleanup(ctx)
+		defer release(context.Background())
+
+		_, err := BuildTLSListener(baseListener, tlsConfig).Build(ctx)
+		require.NoError(t, err)
+
+		require.Equal(t, []config.ReportEntry{
+			{Key: "TLSListener.tlsConfig", Value: config.Redacted, Source: config.Source{Kind: config.SourceFile, File: "tls.pem"}},
+		}, report.Entries())
+	})
 }
 
 func TestRuntime_Run(t *testing.T) {
@@ -477,6 +796,66 @@ func TestRuntime_Run(t *testing.T) {
 		}
 	})
 
+	t.Run("closes stuck connections once the shutdown deadline passes", func(t *testing.T) {
+		listenerBuilder := bedrock.Map(BuildTCPListener(config.ReaderOf(&net.TCPAddr{Port: 0})), func(ctx context.Context, ln *net.TCPListener) (net.Listener, error) {
+			return ln, nil
+		})
+
+		stuck := make(chan struct{})
+		defer close(stuck)
+
+		handlerBuilder := bedrock.BuilderFunc[http.Handler](func(ctx context.Context) (http.Handler, error) {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				<-stuck
+			}), nil
+		})
+
+		runtime, err := Build(listenerBuilder, handlerBuilder).Build(context.Background())
+		require.NoError(t, err)
+
+		addr := runtime.ls.Addr().String()
+
+		runCtx, cancel := context.WithCancel(context.Background())
+
+		runtimeErrCh := make(chan error, 1)
+		runner := bedrock.GracefulShutdown(bedrock.DefaultRunner[bedrock.Runtime](), 100*time.Millisecond)
+		go func() {
+			runner.Run(runCtx, bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+				err := runtime.Run(ctx)
+				runtimeErrCh <- err
+				return err
+			})))
+		}()
+
+		time.Sleep(100 * time.Millisecond)
+
+		requestDone := make(chan struct{})
+		go func() {
+			resp, err := http.Get("http://" + addr)
+			if err == nil {
+				resp.Body.Close()
+			}
+			close(requestDone)
+		}()
+
+		time.Sleep(50 * time.Millisecond)
+
+		cancel()
+
+		select {
+		case err := <-runtimeErrCh:
+			require.ErrorIs(t, err, bedrock.ErrShutdownTimeout)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for shutdown")
+		}
+
+		select {
+		case <-requestDone:
+		case <-time.After(5 * time.Second):
+			t.Fatal("stuck connection was not closed")
+		}
+	})
+
 	t.Run("suppresses context.Canceled error", func(t *testing.T) {
 		listenerBuilder := bedrock.Map(BuildTCPListener(config.ReaderOf(&net.TCPAddr{Port: 0})), func(ctx context.Context, ln *net.TCPListener) (net.Listener, error) {
 			return ln, nil
@@ -515,3 +894,32 @@ func TestRuntime_Run(t *testing.T) {
 		}
 	})
 }
+
+func TestRuntime_Addr(t *testing.T) {
+	t.Run("returns the address chosen for port 0", func(t *testing.T) {
+		listener := bedrock.Map(BuildTCPListener(config.ReaderOf(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})), func(ctx context.Context, ln *net.TCPListener) (net.Listener, error) {
+			return ln, nil
+		})
+		handler := bedrock.BuilderOf[http.Handler](http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+		app := bedrocktest.Start(t, Build(listener, handler))
+
+		addr := app.Runtime().Addr().(*net.TCPAddr)
+		require.NotZero(t, addr.Port)
+
+		client := &http.Client{}
+		defer client.CloseIdleConnections()
+
+		resp, err := client.Get("http://" + addr.String())
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+
+	t.Run("returns nil in dry run mode", func(t *testing.T) {
+		var rt Runtime
+		require.Nil(t, rt.Addr())
+	})
+}
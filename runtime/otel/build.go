@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package otel
+
+import (
+	"context"
+	"time"
+
+	"github.com/z5labs/bedrock"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/z5labs/bedrock/runtime/otel"
+
+// RecordBuilds creates a span using tp for every build recorded in g, nested
+// according to which component depended on which, beneath a single
+// "bedrock.startup" span covering the whole build.
+//
+// The components of an application are usually built before any TracerProvider
+// is available, so the spans are created after the fact with the original start
+// and end time of each build. Builds which failed have their status set to Error.
+func RecordBuilds(ctx context.Context, tp trace.TracerProvider, g *bedrock.BuildGraph) {
+	records := g.Records()
+	if len(records) == 0 {
+		return
+	}
+
+	var first, last time.Time
+	for _, r := range records {
+		if first.IsZero() || r.Start.Before(first) {
+			first = r.Start
+		}
+		if end := r.Start.Add(r.Duration); end.After(last) {
+			last = end
+		}
+	}
+
+	tracer := tp.Tracer(instrumentationName)
+	startupCtx, startup := tracer.Start(ctx, "bedrock.startup", trace.WithTimestamp(first))
+
+	spanCtxs := make(map[int]context.Context, len(records))
+	for _, r := range records {
+		parentCtx, ok := spanCtxs[r.Parent]
+		if !ok {
+			parentCtx = startupCtx
+		}
+
+		spanCtx, span := tracer.Start(
+			parentCtx,
+			r.Name,
+			trace.WithTimestamp(r.Start),
+			trace.WithAttributes(attribute.String("bedrock.component", r.Name)),
+		)
+		if r.Err != nil {
+			span.RecordError(r.Err, trace.WithTimestamp(r.Start.Add(r.Duration)))
+			span.SetStatus(codes.Error, r.Err.Error())
+		}
+		span.End(trace.WithTimestamp(r.Start.Add(r.Duration)))
+
+		spanCtxs[r.ID] = spanCtx
+	}
+
+	startup.End(trace.WithTimestamp(last))
+}
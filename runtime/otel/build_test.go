@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRecordBuilds(t *testing.T) {
+	t.Run("creates nested spans for each build", func(t *testing.T) {
+		buildErr := errors.New("dial failed")
+		db := bedrock.Named("db", bedrock.BuilderFunc[string](func(ctx context.Context) (string, error) {
+			return "", buildErr
+		}))
+		cache := bedrock.Named("cache", bedrock.BuilderOf("cache"))
+		app := bedrock.Named("app", bedrock.Bind(cache, func(ctx context.Context, cache string) bedrock.Builder[string] {
+			return db
+		}))
+
+		ctx, g := bedrock.WithBuildGraph(context.Background())
+		_, err := app.Build(ctx)
+		require.Equal(t, buildErr, err)
+
+		rec := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+
+		RecordBuilds(context.Background(), tp, g)
+
+		spans := rec.Ended()
+		require.Len(t, spans, 4)
+
+		byName := make(map[string]sdktrace.ReadOnlySpan, len(spans))
+		for _, span := range spans {
+			byName[span.Name()] = span
+		}
+
+		startup := byName["bedrock.startup"]
+		require.NotNil(t, startup)
+		require.Equal(t, startup.SpanContext().SpanID(), byName["app"].Parent().SpanID())
+		require.Equal(t, byName["app"].SpanContext().SpanID(), byName["cache"].Parent().SpanID())
+		require.Equal(t, byName["app"].SpanContext().SpanID(), byName["db"].Parent().SpanID())
+
+		for _, r := range g.Records() {
+			span := byName[r.Name]
+			require.Equal(t, r.Start, span.StartTime())
+			require.Equal(t, r.Start.Add(r.Duration), span.EndTime())
+		}
+		require.Equal(t, byName["app"].StartTime(), startup.StartTime())
+		require.Equal(t, byName["app"].EndTime(), startup.EndTime())
+
+		require.Equal(t, codes.Error, byName["db"].Status().Code)
+		require.Equal(t, "dial failed", byName["db"].Status().Description)
+		require.Equal(t, codes.Unset, byName["cache"].Status().Code)
+	})
+
+	t.Run("does nothing without any recorded builds", func(t *testing.T) {
+		_, g := bedrock.WithBuildGraph(context.Background())
+
+		rec := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+
+		RecordBuilds(context.Background(), tp, g)
+		require.Empty(t, rec.Ended())
+	})
+}
@@ -68,4 +68,32 @@
 //  3. Shuts down all providers when the wrapped runtime completes
 //
 // Any errors from provider shutdown are joined with the runtime error.
+//
+// Every processor, reader and provider, as well as the exporters built by the otlp
+// and stdout subpackages, is also shut down with bedrock.OnCleanup, so nothing is
+// left running when a sibling component fails to build. Shutting down a component
+// which has already been shut down by its parent, or by the Runtime, is a no-op.
+// Exporters given to a processor or reader are only shut down by it.
+//
+// In dry run mode, see bedrock.DryRun, the builders read their configuration but
+// create no processors, readers or providers.
+//
+// # Tracing Startup
+//
+// RecordBuilds traces the builds recorded in a bedrock.BuildGraph, with a span for each
+// named component nested beneath the component which depends on it. Runtime.Run does
+// this automatically when the application was built with bedrock.ReportStartup:
+//
+//	runner := bedrock.ReportStartup(bedrock.DefaultRunner[bedrock.Runtime](), func(ctx context.Context, g *bedrock.BuildGraph) {
+//	    g.WriteReport(os.Stderr)
+//	})
+//
+// # Recording Panics
+//
+// RecordPanic records a *bedrock.PanicError, including its stack trace, on the active
+// span and emits it through the global LoggerProvider:
+//
+//	if err := runner.Run(ctx, app); err != nil {
+//	    otel.RecordPanic(ctx, err)
+//	}
 package otel
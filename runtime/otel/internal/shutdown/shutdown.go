@@ -0,0 +1,36 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package shutdown releases the exporters built by the otlp and stdout packages.
+package shutdown
+
+import (
+	"context"
+
+	"github.com/z5labs/bedrock"
+)
+
+// Shutdowner is implemented by every OpenTelemetry exporter.
+type Shutdowner interface {
+	Shutdown(ctx context.Context) error
+}
+
+type ownedKey struct{}
+
+// Owned returns a copy of ctx for building an exporter which is given to a
+// processor or reader, which shuts the exporter down itself once it is shut down.
+func Owned(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ownedKey{}, true)
+}
+
+// OnCleanup registers e to be shut down once the components built with ctx are
+// released, unless ctx was returned by [Owned]. Exporters are only ever shut down
+// once, since shutting down a metric exporter which is already shut down fails.
+func OnCleanup(ctx context.Context, e Shutdowner) {
+	if owned, _ := ctx.Value(ownedKey{}).(bool); owned {
+		return
+	}
+	bedrock.OnCleanup(ctx, e.Shutdown)
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package shutdown
+
+import (
+	"context"
+	"testing"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/require"
+)
+
+type countingExporter struct {
+	shutdowns int
+}
+
+func (e *countingExporter) Shutdown(ctx context.Context) error {
+	e.shutdowns++
+	return nil
+}
+
+func TestOnCleanup(t *testing.T) {
+	t.Run("shuts down the exporter once released", func(t *testing.T) {
+		ctx, release := bedrock.WithCleanup(context.Background())
+
+		e := &countingExporter{}
+		OnCleanup(ctx, e)
+		require.Zero(t, e.shutdowns)
+
+		err := release(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 1, e.shutdowns)
+	})
+
+	t.Run("leaves owned exporters to their owner", func(t *testing.T) {
+		ctx, release := bedrock.WithCleanup(context.Background())
+
+		e := &countingExporter{}
+		OnCleanup(Owned(ctx), e)
+
+		err := release(context.Background())
+		require.NoError(t, err)
+		require.Zero(t, e.shutdowns)
+	})
+}
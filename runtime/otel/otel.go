@@ -12,6 +12,7 @@ import (
 
 	"github.com/z5labs/bedrock"
 	"github.com/z5labs/bedrock/config"
+	"github.com/z5labs/bedrock/runtime/otel/internal/shutdown"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/log"
@@ -29,11 +30,16 @@ import (
 // the trace ID. The ratio parameter determines the fraction of traces to sample, where
 // 0.0 samples no traces and 1.0 samples all traces.
 func BuildTraceIDRatioBasedSampler(ratio config.Reader[float64]) bedrock.Builder[sdktrace.Sampler] {
-	return bedrock.BuilderFunc[sdktrace.Sampler](func(ctx context.Context) (sdktrace.Sampler, error) {
-		sampler := sdktrace.TraceIDRatioBased(config.Must(ctx, ratio))
+	return bedrock.Named("TraceIDRatioBasedSampler", bedrock.BuilderFunc[sdktrace.Sampler](func(ctx context.Context) (sdktrace.Sampler, error) {
+		r, err := bedrock.ReadConfig(ctx, "ratio", ratio)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("TraceIDRatioBasedSampler", err)
+		}
+
+		sampler := sdktrace.TraceIDRatioBased(r)
 
 		return sampler, nil
-	})
+	}))
 }
 
 // BuildBatchSpanProcessor returns a Builder that creates a span processor which batches
@@ -43,13 +49,20 @@ func BuildBatchSpanProcessor[E sdktrace.SpanExporter](
 	exporterBuilder bedrock.Builder[E],
 	// TODO: add options
 ) bedrock.Builder[sdktrace.SpanProcessor] {
-	return bedrock.BuilderFunc[sdktrace.SpanProcessor](func(ctx context.Context) (sdktrace.SpanProcessor, error) {
-		bsp := sdktrace.NewBatchSpanProcessor(
-			bedrock.MustBuild(ctx, exporterBuilder),
-		)
+	return bedrock.Named("BatchSpanProcessor", bedrock.BuilderFunc[sdktrace.SpanProcessor](func(ctx context.Context) (sdktrace.SpanProcessor, error) {
+		exporter, err := exporterBuilder.Build(shutdown.Owned(ctx))
+		if err != nil {
+			return nil, bedrock.WrapBuildError("BatchSpanProcessor", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		bsp := sdktrace.NewBatchSpanProcessor(exporter)
+		bedrock.OnCleanup(ctx, bsp.Shutdown)
 
 		return bsp, nil
-	})
+	}))
 }
 
 // BuildTracerProvider returns a Builder that creates a TracerProvider configured with
@@ -60,15 +73,34 @@ func BuildTracerProvider[S sdktrace.Sampler, P sdktrace.SpanProcessor](
 	samplerBuilder bedrock.Builder[S],
 	spanProcessorBuilder bedrock.Builder[P],
 ) bedrock.Builder[*sdktrace.TracerProvider] {
-	return bedrock.BuilderFunc[*sdktrace.TracerProvider](func(ctx context.Context) (*sdktrace.TracerProvider, error) {
+	return bedrock.Named("TracerProvider", bedrock.BuilderFunc[*sdktrace.TracerProvider](func(ctx context.Context) (*sdktrace.TracerProvider, error) {
+		res, err := resourceBuilder.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("TracerProvider", err)
+		}
+
+		sampler, err := samplerBuilder.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("TracerProvider", err)
+		}
+
+		spanProcessor, err := spanProcessorBuilder.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("TracerProvider", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
 		tp := sdktrace.NewTracerProvider(
-			sdktrace.WithResource(bedrock.MustBuild(ctx, resourceBuilder)),
-			sdktrace.WithSampler(bedrock.MustBuild(ctx, samplerBuilder)),
-			sdktrace.WithSpanProcessor(bedrock.MustBuild(ctx, spanProcessorBuilder)),
+			sdktrace.WithResource(res),
+			sdktrace.WithSampler(sampler),
+			sdktrace.WithSpanProcessor(spanProcessor),
 		)
+		bedrock.OnCleanup(ctx, tp.Shutdown)
 
 		return tp, nil
-	})
+	}))
 }
 
 // BuildPeriodicReader returns a Builder that creates a metric reader which periodically
@@ -78,13 +110,22 @@ func BuildPeriodicReader[E sdkmetric.Exporter](
 	exporterBuilder bedrock.Builder[E],
 	// TODO: add options
 ) bedrock.Builder[*sdkmetric.PeriodicReader] {
-	return bedrock.BuilderFunc[*sdkmetric.PeriodicReader](func(ctx context.Context) (*sdkmetric.PeriodicReader, error) {
-		pr := sdkmetric.NewPeriodicReader(
-			bedrock.MustBuild(ctx, exporterBuilder),
-		)
+	return bedrock.Named("PeriodicReader", bedrock.BuilderFunc[*sdkmetric.PeriodicReader](func(ctx context.Context) (*sdkmetric.PeriodicReader, error) {
+		exporter, err := exporterBuilder.Build(shutdown.Owned(ctx))
+		if err != nil {
+			return nil, bedrock.WrapBuildError("PeriodicReader", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		pr := sdkmetric.NewPeriodicReader(exporter)
+		bedrock.OnCleanup(ctx, func(ctx context.Context) error {
+			return ignoreReaderShutdown(pr.Shutdown(ctx))
+		})
 
 		return pr, nil
-	})
+	}))
 }
 
 // BuildMeterProvider returns a Builder that creates a MeterProvider configured with
@@ -94,14 +135,30 @@ func BuildMeterProvider[R sdkmetric.Reader](
 	resourceBuilder bedrock.Builder[*resource.Resource],
 	readerBuilder bedrock.Builder[R],
 ) bedrock.Builder[*sdkmetric.MeterProvider] {
-	return bedrock.BuilderFunc[*sdkmetric.MeterProvider](func(ctx context.Context) (*sdkmetric.MeterProvider, error) {
+	return bedrock.Named("MeterProvider", bedrock.BuilderFunc[*sdkmetric.MeterProvider](func(ctx context.Context) (*sdkmetric.MeterProvider, error) {
+		res, err := resourceBuilder.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("MeterProvider", err)
+		}
+
+		reader, err := readerBuilder.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("MeterProvider", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
 		mp := sdkmetric.NewMeterProvider(
-			sdkmetric.WithResource(bedrock.MustBuild(ctx, resourceBuilder)),
-			sdkmetric.WithReader(bedrock.MustBuild(ctx, readerBuilder)),
+			sdkmetric.WithResource(res),
+			sdkmetric.WithReader(reader),
 		)
+		bedrock.OnCleanup(ctx, func(ctx context.Context) error {
+			return ignoreReaderShutdown(mp.Shutdown(ctx))
+		})
 
 		return mp, nil
-	})
+	}))
 }
 
 // BuildBatchLogProcessor returns a Builder that creates a log processor which batches
@@ -111,13 +168,20 @@ func BuildBatchLogProcessor[E sdklog.Exporter](
 	exporterBuilder bedrock.Builder[E],
 	// TODO: add options
 ) bedrock.Builder[*sdklog.BatchProcessor] {
-	return bedrock.BuilderFunc[*sdklog.BatchProcessor](func(ctx context.Context) (*sdklog.BatchProcessor, error) {
-		bp := sdklog.NewBatchProcessor(
-			bedrock.MustBuild(ctx, exporterBuilder),
-		)
+	return bedrock.Named("BatchLogProcessor", bedrock.BuilderFunc[*sdklog.BatchProcessor](func(ctx context.Context) (*sdklog.BatchProcessor, error) {
+		exporter, err := exporterBuilder.Build(shutdown.Owned(ctx))
+		if err != nil {
+			return nil, bedrock.WrapBuildError("BatchLogProcessor", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		bp := sdklog.NewBatchProcessor(exporter)
+		bedrock.OnCleanup(ctx, bp.Shutdown)
 
 		return bp, nil
-	})
+	}))
 }
 
 // BuildLoggerProvider returns a Builder that creates a LoggerProvider configured with
@@ -127,14 +191,38 @@ func BuildLoggerProvider[P sdklog.Processor](
 	resourceBuilder bedrock.Builder[*resource.Resource],
 	processorBuilder bedrock.Builder[P],
 ) bedrock.Builder[*sdklog.LoggerProvider] {
-	return bedrock.BuilderFunc[*sdklog.LoggerProvider](func(ctx context.Context) (*sdklog.LoggerProvider, error) {
+	return bedrock.Named("LoggerProvider", bedrock.BuilderFunc[*sdklog.LoggerProvider](func(ctx context.Context) (*sdklog.LoggerProvider, error) {
+		res, err := resourceBuilder.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("LoggerProvider", err)
+		}
+
+		processor, err := processorBuilder.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("LoggerProvider", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
 		lp := sdklog.NewLoggerProvider(
-			sdklog.WithResource(bedrock.MustBuild(ctx, resourceBuilder)),
-			sdklog.WithProcessor(bedrock.MustBuild(ctx, processorBuilder)),
+			sdklog.WithResource(res),
+			sdklog.WithProcessor(processor),
 		)
+		bedrock.OnCleanup(ctx, lp.Shutdown)
 
 		return lp, nil
-	})
+	}))
+}
+
+// ignoreReaderShutdown ignores the error returned by metric readers, and the
+// MeterProviders which use them, once they have already been shut down, e.g. by
+// Runtime.Run, since unlike the trace and log SDKs shutting them down again fails.
+func ignoreReaderShutdown(err error) error {
+	if errors.Is(err, sdkmetric.ErrReaderShutdown) {
+		return nil
+	}
+	return err
 }
 
 // RuntimeOptions holds configuration options for the OpenTelemetry runtime wrapper
@@ -146,6 +234,8 @@ type RuntimeOptions struct {
 type RuntimeOption func(*RuntimeOptions)
 
 // ShutdownGracePeriod sets the duration to wait for OpenTelemetry providers to shut down.
+// When run by bedrock.GracefulShutdown, the providers are also bound by its overall
+// shutdown deadline, whichever expires first.
 //
 // Default is 30 seconds.
 func ShutdownGracePeriod(d time.Duration) RuntimeOption {
@@ -177,6 +267,14 @@ type Runtime[
 // BuildRuntime returns a Builder that creates a Runtime wrapping the provided runtime
 // with OpenTelemetry providers. The text map propagator is used for context propagation
 // across service boundaries.
+//
+// The providers and the wrapped runtime are built concurrently. Build failures are
+// returned as a *bedrock.BuildError whose Path starts with "otel.Runtime" followed by
+// the names of the nested components which failed.
+//
+// In dry run mode, see bedrock.DryRun, the configuration of every component is read
+// but no processors, readers or providers are created, so no exporting goroutines are
+// started, and the zero Runtime is returned.
 func BuildRuntime[
 	E otel.ErrorHandler,
 	T trace.TracerProvider,
@@ -192,32 +290,52 @@ func BuildRuntime[
 	runtimeBuilder bedrock.Builder[R],
 	opts ...RuntimeOption,
 ) bedrock.Builder[Runtime[E, T, M, L, R]] {
-	return bedrock.BuilderFunc[Runtime[E, T, M, L, R]](func(ctx context.Context) (Runtime[E, T, M, L, R], error) {
-		ro := &RuntimeOptions{
-			// 30 seconds aligns with the K8s default terminationGracePeriodSeconds
-			shutdownGracePeriod: 30 * time.Second,
+	ro := &RuntimeOptions{
+		// 30 seconds aligns with the K8s default terminationGracePeriodSeconds
+		shutdownGracePeriod: 30 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(ro)
+	}
+
+	rt := bedrock.Zip6(
+		errorHandler,
+		textMapPropagatorBuilder,
+		tracerProviderBuilder,
+		meterProviderBuilder,
+		loggerProviderBuilder,
+		runtimeBuilder,
+		func(
+			_ context.Context,
+			errorHandler E,
+			textMapPropagator propagation.TextMapPropagator,
+			tracerProvider T,
+			meterProvider M,
+			loggerProvider L,
+			runtime R,
+		) (Runtime[E, T, M, L, R], error) {
+			return Runtime[E, T, M, L, R]{
+				errorHandler:        errorHandler,
+				textMapPropagator:   textMapPropagator,
+				tracerProvider:      tracerProvider,
+				meterProvider:       meterProvider,
+				loggerProvider:      loggerProvider,
+				runtime:             runtime,
+				shutdownGracePeriod: ro.shutdownGracePeriod,
+			}, nil
+		},
+	)
+
+	return bedrock.Named("otel.Runtime", bedrock.BuilderFunc[Runtime[E, T, M, L, R]](func(ctx context.Context) (Runtime[E, T, M, L, R], error) {
+		r, err := rt.Build(ctx)
+		if err != nil {
+			return r, bedrock.WrapBuildError("otel.Runtime", err)
 		}
-		for _, opt := range opts {
-			opt(ro)
+		if bedrock.DryRun(ctx) {
+			return Runtime[E, T, M, L, R]{}, nil
 		}
-
-		errorHandler := bedrock.MustBuild(ctx, errorHandler)
-		textMapPropagator := bedrock.MustBuild(ctx, textMapPropagatorBuilder)
-		tracerProvider := bedrock.MustBuild(ctx, tracerProviderBuilder)
-		meterProvider := bedrock.MustBuild(ctx, meterProviderBuilder)
-		loggerProvider := bedrock.MustBuild(ctx, loggerProviderBuilder)
-		runtime := bedrock.MustBuild(ctx, runtimeBuilder)
-
-		return Runtime[E, T, M, L, R]{
-			errorHandler:        errorHandler,
-			textMapPropagator:   textMapPropagator,
-			tracerProvider:      tracerProvider,
-			meterProvider:       meterProvider,
-			loggerProvider:      loggerProvider,
-			runtime:             runtime,
-			shutdownGracePeriod: ro.shutdownGracePeriod,
-		}, nil
-	})
+		return r, nil
+	}))
 }
 
 type shutdownInterface interface {
@@ -227,6 +345,9 @@ type shutdownInterface interface {
 // Run registers the OpenTelemetry providers globally, executes the wrapped runtime,
 // and shuts down all providers when complete. Provider shutdown errors are joined
 // with any error from the wrapped runtime.
+//
+// If the application was built with a bedrock.BuildGraph, e.g. by bedrock.ReportStartup,
+// the recorded builds are traced with RecordBuilds once the TracerProvider is registered.
 func (r Runtime[E, T, M, L, R]) Run(ctx context.Context) (err error) {
 	shutdownFuncs := make([]func(context.Context) error, 3)
 
@@ -238,6 +359,9 @@ func (r Runtime[E, T, M, L, R]) Run(ctx context.Context) (err error) {
 	if sd, ok := any(r.tracerProvider).(shutdownInterface); ok {
 		shutdownFuncs[0] = sd.Shutdown
 	}
+	if g, ok := bedrock.BuildGraphFromContext(ctx); ok {
+		RecordBuilds(ctx, r.tracerProvider, g)
+	}
 
 	otel.SetMeterProvider(r.meterProvider)
 	if sd, ok := any(r.meterProvider).(shutdownInterface); ok {
@@ -250,7 +374,10 @@ func (r Runtime[E, T, M, L, R]) Run(ctx context.Context) (err error) {
 	}
 
 	defer func() {
-		ctx, cancel := context.WithTimeout(context.Background(), r.shutdownGracePeriod)
+		shutdownCtx, cancelShutdown := bedrock.ShutdownContext(ctx)
+		defer cancelShutdown()
+
+		ctx, cancel := context.WithTimeout(shutdownCtx, r.shutdownGracePeriod)
 		defer cancel()
 
 		shutdownErrs := make([]error, 3)
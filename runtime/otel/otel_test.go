@@ -8,15 +8,18 @@ package otel
 import (
 	"context"
 	"errors"
+	"net/http"
 	"sync/atomic"
 	"testing"
 
 	"github.com/z5labs/bedrock"
 	"github.com/z5labs/bedrock/config"
 	"github.com/z5labs/bedrock/runtime/otel/noop"
+	"github.com/z5labs/bedrock/runtime/otel/otlp"
 
 	"github.com/stretchr/testify/require"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/log"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/propagation"
@@ -24,6 +27,7 @@ import (
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	"go.opentelemetry.io/otel/trace"
 )
 
@@ -83,6 +87,17 @@ type noShutdownLoggerProvider struct {
 	log.LoggerProvider
 }
 
+// shutdownSpanExporter counts how often it is shut down
+type shutdownSpanExporter struct {
+	noop.SpanExporter
+	shutdowns atomic.Int32
+}
+
+func (e *shutdownSpanExporter) Shutdown(ctx context.Context) error {
+	e.shutdowns.Add(1)
+	return nil
+}
+
 // buildTestErrorHandler creates an ErrorHandler builder for testing
 func buildTestErrorHandler() bedrock.Builder[otel.ErrorHandler] {
 	return bedrock.BuilderOf[otel.ErrorHandler](mockErrorHandler{})
@@ -145,6 +160,41 @@ func TestRuntime_Run(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("traces the recorded builds", func(t *testing.T) {
+		resourceB := buildTestResource()
+		rec := tracetest.NewSpanRecorder()
+
+		runtimeB := BuildRuntime(
+			buildTestErrorHandler(),
+			bedrock.BuilderOf(propagation.NewCompositeTextMapPropagator()),
+			bedrock.BuilderOf(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))),
+			buildTestMeterProvider(resourceB),
+			buildTestLoggerProvider(resourceB),
+			bedrock.BuilderOf(bedrock.RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			})),
+		)
+
+		var reported *bedrock.BuildGraph
+		runner := bedrock.ReportStartup(bedrock.DefaultRunner[bedrock.Runtime](), func(ctx context.Context, g *bedrock.BuildGraph) {
+			reported = g
+		})
+
+		err := runner.Run(context.Background(), bedrock.Map(runtimeB, func(ctx context.Context, rt Runtime[otel.ErrorHandler, *sdktrace.TracerProvider, *sdkmetric.MeterProvider, *sdklog.LoggerProvider, bedrock.RuntimeFunc]) (bedrock.Runtime, error) {
+			return rt, nil
+		}))
+		require.NoError(t, err)
+		require.NotNil(t, reported)
+
+		var names []string
+		for _, span := range rec.Ended() {
+			names = append(names, span.Name())
+		}
+		require.Contains(t, names, "bedrock.startup")
+		require.Contains(t, names, "otel.Runtime")
+		require.Contains(t, names, "MeterProvider")
+	})
+
 	t.Run("wrapped runtime is called", func(t *testing.T) {
 		resourceB := buildTestResource()
 		runtimeCalled := false
@@ -363,7 +413,7 @@ func TestBuildTraceIDRatioBasedSampler(t *testing.T) {
 		require.NotNil(t, sampler)
 	})
 
-	t.Run("reader error panics", func(t *testing.T) {
+	t.Run("reader error returns build error", func(t *testing.T) {
 		expectedErr := errors.New("reader failed")
 		failingReader := config.ReaderFunc[float64](func(ctx context.Context) (config.Value[float64], error) {
 			return config.Value[float64]{}, expectedErr
@@ -371,17 +421,25 @@ func TestBuildTraceIDRatioBasedSampler(t *testing.T) {
 
 		builder := BuildTraceIDRatioBasedSampler(failingReader)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+		require.ErrorIs(t, err, expectedErr)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"TraceIDRatioBasedSampler"}, buildErr.Path)
+		require.Equal(t, "ratio", buildErr.Key)
 	})
 
-	t.Run("empty reader panics", func(t *testing.T) {
+	t.Run("empty reader returns build error", func(t *testing.T) {
 		builder := BuildTraceIDRatioBasedSampler(config.EmptyReader[float64]())
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"TraceIDRatioBasedSampler"}, buildErr.Path)
+		require.Equal(t, "ratio", buildErr.Key)
 	})
 }
 
@@ -393,7 +451,7 @@ func TestBuildBatchSpanProcessor(t *testing.T) {
 		require.NotNil(t, processor)
 	})
 
-	t.Run("exporter error panics", func(t *testing.T) {
+	t.Run("exporter error returns build error", func(t *testing.T) {
 		expectedErr := errors.New("exporter build failed")
 		failingBuilder := bedrock.BuilderFunc[noop.SpanExporter](func(ctx context.Context) (noop.SpanExporter, error) {
 			return noop.SpanExporter{}, expectedErr
@@ -401,9 +459,12 @@ func TestBuildBatchSpanProcessor(t *testing.T) {
 
 		builder := BuildBatchSpanProcessor(failingBuilder)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+		require.ErrorIs(t, err, expectedErr)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"BatchSpanProcessor"}, buildErr.Path)
 	})
 }
 
@@ -419,7 +480,7 @@ func TestBuildTracerProvider(t *testing.T) {
 		require.NotNil(t, provider)
 	})
 
-	t.Run("resource error panics", func(t *testing.T) {
+	t.Run("resource error returns build error", func(t *testing.T) {
 		expectedErr := errors.New("resource build failed")
 		failingResourceB := bedrock.BuilderFunc[*resource.Resource](func(ctx context.Context) (*resource.Resource, error) {
 			return nil, expectedErr
@@ -429,12 +490,15 @@ func TestBuildTracerProvider(t *testing.T) {
 
 		builder := BuildTracerProvider(failingResourceB, samplerB, processorB)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+		require.ErrorIs(t, err, expectedErr)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"TracerProvider"}, buildErr.Path)
 	})
 
-	t.Run("sampler error panics", func(t *testing.T) {
+	t.Run("sampler error returns build error", func(t *testing.T) {
 		resourceB := buildTestResource()
 		failingReader := config.ReaderFunc[float64](func(ctx context.Context) (config.Value[float64], error) {
 			return config.Value[float64]{}, errors.New("sampler read failed")
@@ -444,12 +508,15 @@ func TestBuildTracerProvider(t *testing.T) {
 
 		builder := BuildTracerProvider(resourceB, samplerB, processorB)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"TracerProvider", "TraceIDRatioBasedSampler"}, buildErr.Path)
+		require.Equal(t, "ratio", buildErr.Key)
 	})
 
-	t.Run("processor error panics", func(t *testing.T) {
+	t.Run("processor error returns build error", func(t *testing.T) {
 		resourceB := buildTestResource()
 		samplerB := BuildTraceIDRatioBasedSampler(config.ReaderOf(1.0))
 		failingProcessorB := bedrock.BuilderFunc[sdktrace.SpanProcessor](func(ctx context.Context) (sdktrace.SpanProcessor, error) {
@@ -458,9 +525,11 @@ func TestBuildTracerProvider(t *testing.T) {
 
 		builder := BuildTracerProvider(resourceB, samplerB, failingProcessorB)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"TracerProvider"}, buildErr.Path)
 	})
 }
 
@@ -472,7 +541,7 @@ func TestBuildPeriodicReader(t *testing.T) {
 		require.NotNil(t, reader)
 	})
 
-	t.Run("exporter error panics", func(t *testing.T) {
+	t.Run("exporter error returns build error", func(t *testing.T) {
 		expectedErr := errors.New("exporter build failed")
 		failingBuilder := bedrock.BuilderFunc[noop.MetricExporter](func(ctx context.Context) (noop.MetricExporter, error) {
 			return noop.MetricExporter{}, expectedErr
@@ -480,9 +549,27 @@ func TestBuildPeriodicReader(t *testing.T) {
 
 		builder := BuildPeriodicReader(failingBuilder)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+		require.ErrorIs(t, err, expectedErr)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"PeriodicReader"}, buildErr.Path)
+	})
+
+	t.Run("leaves shutting down the exporter to the reader", func(t *testing.T) {
+		builder := BuildPeriodicReader(otlp.BuildHttpMetricExporter(
+			config.ReaderOf("localhost:4318"),
+			bedrock.BuilderOf(http.DefaultClient),
+		))
+
+		ctx, release := bedrock.WithCleanup(context.Background())
+		reader, err := builder.Build(ctx)
+		require.NoError(t, err)
+		require.NoError(t, reader.Shutdown(context.Background()))
+
+		err = release(context.Background())
+		require.NoError(t, err)
 	})
 }
 
@@ -497,7 +584,7 @@ func TestBuildMeterProvider(t *testing.T) {
 		require.NotNil(t, provider)
 	})
 
-	t.Run("resource error panics", func(t *testing.T) {
+	t.Run("resource error returns build error", func(t *testing.T) {
 		expectedErr := errors.New("resource build failed")
 		failingResourceB := bedrock.BuilderFunc[*resource.Resource](func(ctx context.Context) (*resource.Resource, error) {
 			return nil, expectedErr
@@ -506,12 +593,15 @@ func TestBuildMeterProvider(t *testing.T) {
 
 		builder := BuildMeterProvider(failingResourceB, readerB)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+		require.ErrorIs(t, err, expectedErr)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"MeterProvider"}, buildErr.Path)
 	})
 
-	t.Run("reader error panics", func(t *testing.T) {
+	t.Run("reader error returns build error", func(t *testing.T) {
 		resourceB := buildTestResource()
 		failingReaderB := bedrock.BuilderFunc[*sdkmetric.PeriodicReader](func(ctx context.Context) (*sdkmetric.PeriodicReader, error) {
 			return nil, errors.New("reader build failed")
@@ -519,9 +609,11 @@ func TestBuildMeterProvider(t *testing.T) {
 
 		builder := BuildMeterProvider(resourceB, failingReaderB)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"MeterProvider"}, buildErr.Path)
 	})
 }
 
@@ -533,7 +625,7 @@ func TestBuildBatchLogProcessor(t *testing.T) {
 		require.NotNil(t, processor)
 	})
 
-	t.Run("exporter error panics", func(t *testing.T) {
+	t.Run("exporter error returns build error", func(t *testing.T) {
 		expectedErr := errors.New("exporter build failed")
 		failingBuilder := bedrock.BuilderFunc[noop.LogExporter](func(ctx context.Context) (noop.LogExporter, error) {
 			return noop.LogExporter{}, expectedErr
@@ -541,9 +633,12 @@ func TestBuildBatchLogProcessor(t *testing.T) {
 
 		builder := BuildBatchLogProcessor(failingBuilder)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+		require.ErrorIs(t, err, expectedErr)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"BatchLogProcessor"}, buildErr.Path)
 	})
 }
 
@@ -558,7 +653,7 @@ func TestBuildLoggerProvider(t *testing.T) {
 		require.NotNil(t, provider)
 	})
 
-	t.Run("resource error panics", func(t *testing.T) {
+	t.Run("resource error returns build error", func(t *testing.T) {
 		expectedErr := errors.New("resource build failed")
 		failingResourceB := bedrock.BuilderFunc[*resource.Resource](func(ctx context.Context) (*resource.Resource, error) {
 			return nil, expectedErr
@@ -567,12 +662,15 @@ func TestBuildLoggerProvider(t *testing.T) {
 
 		builder := BuildLoggerProvider(failingResourceB, processorB)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+		require.ErrorIs(t, err, expectedErr)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"LoggerProvider"}, buildErr.Path)
 	})
 
-	t.Run("processor error panics", func(t *testing.T) {
+	t.Run("processor error returns build error", func(t *testing.T) {
 		resourceB := buildTestResource()
 		failingProcessorB := bedrock.BuilderFunc[*sdklog.BatchProcessor](func(ctx context.Context) (*sdklog.BatchProcessor, error) {
 			return nil, errors.New("processor build failed")
@@ -580,9 +678,11 @@ func TestBuildLoggerProvider(t *testing.T) {
 
 		builder := BuildLoggerProvider(resourceB, failingProcessorB)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"LoggerProvider"}, buildErr.Path)
 	})
 }
 
@@ -609,7 +709,7 @@ func TestBuildRuntime(t *testing.T) {
 		require.NoError(t, err)
 	})
 
-	t.Run("error handler error panics", func(t *testing.T) {
+	t.Run("error handler error returns build error", func(t *testing.T) {
 		resourceB := buildTestResource()
 		failingErrorHandlerB := bedrock.BuilderFunc[otel.ErrorHandler](func(ctx context.Context) (otel.ErrorHandler, error) {
 			return nil, errors.New("error handler build failed")
@@ -626,12 +726,14 @@ func TestBuildRuntime(t *testing.T) {
 			})),
 		)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"otel.Runtime"}, buildErr.Path)
 	})
 
-	t.Run("propagator error panics", func(t *testing.T) {
+	t.Run("propagator error returns build error", func(t *testing.T) {
 		resourceB := buildTestResource()
 		failingPropagatorB := bedrock.BuilderFunc[propagation.TextMapPropagator](func(ctx context.Context) (propagation.TextMapPropagator, error) {
 			return nil, errors.New("propagator build failed")
@@ -648,12 +750,14 @@ func TestBuildRuntime(t *testing.T) {
 			})),
 		)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"otel.Runtime"}, buildErr.Path)
 	})
 
-	t.Run("tracer provider error panics", func(t *testing.T) {
+	t.Run("tracer provider error returns build error", func(t *testing.T) {
 		resourceB := buildTestResource()
 		failingTracerB := bedrock.BuilderFunc[*sdktrace.TracerProvider](func(ctx context.Context) (*sdktrace.TracerProvider, error) {
 			return nil, errors.New("tracer provider build failed")
@@ -670,12 +774,14 @@ func TestBuildRuntime(t *testing.T) {
 			})),
 		)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"otel.Runtime"}, buildErr.Path)
 	})
 
-	t.Run("meter provider error panics", func(t *testing.T) {
+	t.Run("meter provider error returns build error", func(t *testing.T) {
 		resourceB := buildTestResource()
 		failingMeterB := bedrock.BuilderFunc[*sdkmetric.MeterProvider](func(ctx context.Context) (*sdkmetric.MeterProvider, error) {
 			return nil, errors.New("meter provider build failed")
@@ -692,12 +798,14 @@ func TestBuildRuntime(t *testing.T) {
 			})),
 		)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"otel.Runtime"}, buildErr.Path)
 	})
 
-	t.Run("logger provider error panics", func(t *testing.T) {
+	t.Run("logger provider error returns build error", func(t *testing.T) {
 		resourceB := buildTestResource()
 		failingLoggerB := bedrock.BuilderFunc[*sdklog.LoggerProvider](func(ctx context.Context) (*sdklog.LoggerProvider, error) {
 			return nil, errors.New("logger provider build failed")
@@ -714,12 +822,14 @@ func TestBuildRuntime(t *testing.T) {
 			})),
 		)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
-		})
+		_, err := builder.Build(context.Background())
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"otel.Runtime"}, buildErr.Path)
 	})
 
-	t.Run("runtime error panics", func(t *testing.T) {
+	t.Run("runtime error returns build error", func(t *testing.T) {
 		resourceB := buildTestResource()
 		failingRuntimeB := bedrock.BuilderFunc[bedrock.Runtime](func(ctx context.Context) (bedrock.Runtime, error) {
 			return nil, errors.New("runtime build failed")
@@ -734,8 +844,169 @@ func TestBuildRuntime(t *testing.T) {
 			failingRuntimeB,
 		)
 
-		require.Panics(t, func() {
-			_, _ = builder.Build(context.Background())
+		_, err := builder.Build(context.Background())
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"otel.Runtime"}, buildErr.Path)
+	})
+	t.Run("records the path to a missing nested setting", func(t *testing.T) {
+		resourceB := buildTestResource()
+
+		builder := BuildRuntime(
+			buildTestErrorHandler(),
+			bedrock.BuilderOf(propagation.NewCompositeTextMapPropagator()),
+			BuildTracerProvider(
+				resourceB,
+				BuildTraceIDRatioBasedSampler(config.EmptyReader[float64]()),
+				BuildBatchSpanProcessor(noop.BuildSpanExporter()),
+			),
+			buildTestMeterProvider(resourceB),
+			buildTestLoggerProvider(resourceB),
+			bedrock.BuilderOf(bedrock.RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			})),
+		)
+
+		_, err := builder.Build(context.Background())
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"otel.Runtime", "TracerProvider", "TraceIDRatioBasedSampler"}, buildErr.Path)
+		require.Equal(t, "ratio", buildErr.Key)
+		require.Equal(t, `build otel.Runtime > TracerProvider > TraceIDRatioBasedSampler: config "ratio": config: value not set`, err.Error())
+	})
+	t.Run("shuts down the built providers if a sibling fails to build", func(t *testing.T) {
+		resourceB := buildTestResource()
+		exporter := &shutdownSpanExporter{}
+		failingLoggerB := bedrock.BuilderFunc[*sdklog.LoggerProvider](func(ctx context.Context) (*sdklog.LoggerProvider, error) {
+			return nil, errors.New("logger provider build failed")
 		})
+
+		builder := BuildRuntime(
+			buildTestErrorHandler(),
+			bedrock.BuilderOf(propagation.NewCompositeTextMapPropagator()),
+			BuildTracerProvider(
+				resourceB,
+				BuildTraceIDRatioBasedSampler(config.ReaderOf(1.0)),
+				BuildBatchSpanProcessor(bedrock.BuilderOf(exporter)),
+			),
+			buildTestMeterProvider(resourceB),
+			failingLoggerB,
+			bedrock.BuilderOf(bedrock.RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			})),
+		)
+
+		ctx, release := bedrock.WithCleanup(context.Background())
+		_, err := builder.Build(ctx)
+		require.Error(t, err)
+
+		err = release(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, int32(1), exporter.shutdowns.Load())
+	})
+
+	t.Run("releasing after running does not fail", func(t *testing.T) {
+		resourceB := buildTestResource()
+
+		builder := BuildRuntime(
+			buildTestErrorHandler(),
+			bedrock.BuilderOf(propagation.NewCompositeTextMapPropagator()),
+			buildTestTracerProvider(resourceB),
+			buildTestMeterProvider(resourceB),
+			buildTestLoggerProvider(resourceB),
+			bedrock.BuilderOf(bedrock.RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			})),
+		)
+
+		ctx, release := bedrock.WithCleanup(context.Background())
+		rt, err := builder.Build(ctx)
+		require.NoError(t, err)
+
+		err = rt.Run(ctx)
+		require.NoError(t, err)
+
+		err = release(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("does not create any processors or providers in dry run mode", func(t *testing.T) {
+		resourceB := buildTestResource()
+		exporter := &shutdownSpanExporter{}
+
+		builder := BuildRuntime(
+			buildTestErrorHandler(),
+			bedrock.BuilderOf(propagation.NewCompositeTextMapPropagator()),
+			BuildTracerProvider(
+				resourceB,
+				BuildTraceIDRatioBasedSampler(config.EmptyReader[float64]()),
+				BuildBatchSpanProcessor(bedrock.BuilderOf(exporter)),
+			),
+			buildTestMeterProvider(resourceB),
+			buildTestLoggerProvider(resourceB),
+			bedrock.BuilderOf(bedrock.RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			})),
+		)
+
+		runner := bedrock.DryRunner[Runtime[otel.ErrorHandler, *sdktrace.TracerProvider, *sdkmetric.MeterProvider, *sdklog.LoggerProvider, bedrock.RuntimeFunc]]()
+		err := runner.Run(context.Background(), builder)
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"otel.Runtime", "TracerProvider", "TraceIDRatioBasedSampler"}, buildErr.Path)
+		require.Zero(t, exporter.shutdowns.Load())
+	})
+
+	t.Run("records the path to a missing exporter setting", func(t *testing.T) {
+		resourceB := buildTestResource()
+
+		builder := BuildRuntime(
+			buildTestErrorHandler(),
+			bedrock.BuilderOf(propagation.NewCompositeTextMapPropagator()),
+			BuildTracerProvider(
+				resourceB,
+				BuildTraceIDRatioBasedSampler(config.ReaderOf(1.0)),
+				BuildBatchSpanProcessor(otlp.BuildHttpSpanExporter(
+					config.EmptyReader[string](),
+					bedrock.BuilderOf(http.DefaultClient),
+				)),
+			),
+			buildTestMeterProvider(resourceB),
+			buildTestLoggerProvider(resourceB),
+			bedrock.BuilderOf(bedrock.RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			})),
+		)
+
+		runner := bedrock.DryRunner[Runtime[otel.ErrorHandler, *sdktrace.TracerProvider, *sdkmetric.MeterProvider, *sdklog.LoggerProvider, bedrock.RuntimeFunc]]()
+		err := runner.Run(context.Background(), builder)
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"otel.Runtime", "TracerProvider", "BatchSpanProcessor", "OtlpHttpSpanExporter"}, buildErr.Path)
+		require.Equal(t, "endpoint", buildErr.Key)
+	})
+
+	t.Run("does not create any exporters in dry run mode", func(t *testing.T) {
+		exporter := &otlptrace.Exporter{}
+		builder := bedrock.Map(
+			otlp.BuildHttpSpanExporter(config.ReaderOf("localhost:4318"), bedrock.BuilderOf(http.DefaultClient)),
+			func(ctx context.Context, e *otlptrace.Exporter) (bedrock.Runtime, error) {
+				exporter = e
+				return bedrock.RuntimeFunc(func(ctx context.Context) error {
+					return nil
+				}), nil
+			},
+		)
+
+		err := bedrock.DryRunner[bedrock.Runtime]().Run(context.Background(), builder)
+		require.NoError(t, err)
+		require.Nil(t, exporter)
 	})
 }
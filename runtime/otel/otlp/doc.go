@@ -11,4 +11,7 @@
 // configuration readers and connection builders as inputs. The gRPC variants require
 // a Builder for *grpc.ClientConn, while HTTP variants require an endpoint reader and
 // an HTTP client builder.
+//
+// In dry run mode, see bedrock.DryRun, the builders read their configuration and
+// build their dependencies but create no exporters.
 package otlp
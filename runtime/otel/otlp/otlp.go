@@ -11,6 +11,7 @@ import (
 
 	"github.com/z5labs/bedrock"
 	"github.com/z5labs/bedrock/config"
+	"github.com/z5labs/bedrock/runtime/otel/internal/shutdown"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
@@ -26,12 +27,26 @@ import (
 // gRPC transport. The exporter sends trace data to an OTLP-compatible collector
 // over the provided gRPC connection.
 func BuildGrpcSpanExporter(grpcConnB bedrock.Builder[*grpc.ClientConn]) bedrock.BuilderFunc[*otlptrace.Exporter] {
-	return func(ctx context.Context) (*otlptrace.Exporter, error) {
-		return otlptracegrpc.New(
+	return bedrock.BuilderFunc[*otlptrace.Exporter](bedrock.Named("OtlpGrpcSpanExporter", bedrock.BuilderFunc[*otlptrace.Exporter](func(ctx context.Context) (*otlptrace.Exporter, error) {
+		conn, err := grpcConnB.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpGrpcSpanExporter", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		exporter, err := otlptracegrpc.New(
 			ctx,
-			otlptracegrpc.WithGRPCConn(bedrock.MustBuild(ctx, grpcConnB)),
+			otlptracegrpc.WithGRPCConn(conn),
 		)
-	}
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpGrpcSpanExporter", err)
+		}
+		shutdown.OnCleanup(ctx, exporter)
+
+		return exporter, nil
+	})).Build)
 }
 
 // BuildHttpSpanExporter returns a Builder that creates an OTLP span exporter using
@@ -41,25 +56,58 @@ func BuildHttpSpanExporter(
 	endpoint config.Reader[string],
 	httpClientB bedrock.Builder[*http.Client],
 ) bedrock.BuilderFunc[*otlptrace.Exporter] {
-	return func(ctx context.Context) (*otlptrace.Exporter, error) {
-		return otlptracehttp.New(
+	return bedrock.BuilderFunc[*otlptrace.Exporter](bedrock.Named("OtlpHttpSpanExporter", bedrock.BuilderFunc[*otlptrace.Exporter](func(ctx context.Context) (*otlptrace.Exporter, error) {
+		ep, err := bedrock.ReadConfig(ctx, "endpoint", endpoint)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpHttpSpanExporter", err)
+		}
+
+		client, err := httpClientB.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpHttpSpanExporter", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		exporter, err := otlptracehttp.New(
 			ctx,
-			otlptracehttp.WithEndpoint(config.Must(ctx, endpoint)),
-			otlptracehttp.WithHTTPClient(bedrock.MustBuild(ctx, httpClientB)),
+			otlptracehttp.WithEndpoint(ep),
+			otlptracehttp.WithHTTPClient(client),
 		)
-	}
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpHttpSpanExporter", err)
+		}
+		shutdown.OnCleanup(ctx, exporter)
+
+		return exporter, nil
+	})).Build)
 }
 
 // BuildGrpcMetricExporter returns a Builder that creates an OTLP metric exporter using
 // gRPC transport. The exporter sends metric data to an OTLP-compatible collector
 // over the provided gRPC connection.
 func BuildGrpcMetricExporter(grpcConnB bedrock.Builder[*grpc.ClientConn]) bedrock.BuilderFunc[*otlpmetricgrpc.Exporter] {
-	return func(ctx context.Context) (*otlpmetricgrpc.Exporter, error) {
-		return otlpmetricgrpc.New(
+	return bedrock.BuilderFunc[*otlpmetricgrpc.Exporter](bedrock.Named("OtlpGrpcMetricExporter", bedrock.BuilderFunc[*otlpmetricgrpc.Exporter](func(ctx context.Context) (*otlpmetricgrpc.Exporter, error) {
+		conn, err := grpcConnB.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpGrpcMetricExporter", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		exporter, err := otlpmetricgrpc.New(
 			ctx,
-			otlpmetricgrpc.WithGRPCConn(bedrock.MustBuild(ctx, grpcConnB)),
+			otlpmetricgrpc.WithGRPCConn(conn),
 		)
-	}
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpGrpcMetricExporter", err)
+		}
+		shutdown.OnCleanup(ctx, exporter)
+
+		return exporter, nil
+	})).Build)
 }
 
 // BuildHttpMetricExporter returns a Builder that creates an OTLP metric exporter using
@@ -69,25 +117,58 @@ func BuildHttpMetricExporter(
 	endpoint config.Reader[string],
 	httpClientB bedrock.Builder[*http.Client],
 ) bedrock.BuilderFunc[*otlpmetrichttp.Exporter] {
-	return func(ctx context.Context) (*otlpmetrichttp.Exporter, error) {
-		return otlpmetrichttp.New(
+	return bedrock.BuilderFunc[*otlpmetrichttp.Exporter](bedrock.Named("OtlpHttpMetricExporter", bedrock.BuilderFunc[*otlpmetrichttp.Exporter](func(ctx context.Context) (*otlpmetrichttp.Exporter, error) {
+		ep, err := bedrock.ReadConfig(ctx, "endpoint", endpoint)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpHttpMetricExporter", err)
+		}
+
+		client, err := httpClientB.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpHttpMetricExporter", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		exporter, err := otlpmetrichttp.New(
 			ctx,
-			otlpmetrichttp.WithEndpoint(config.Must(ctx, endpoint)),
-			otlpmetrichttp.WithHTTPClient(bedrock.MustBuild(ctx, httpClientB)),
+			otlpmetrichttp.WithEndpoint(ep),
+			otlpmetrichttp.WithHTTPClient(client),
 		)
-	}
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpHttpMetricExporter", err)
+		}
+		shutdown.OnCleanup(ctx, exporter)
+
+		return exporter, nil
+	})).Build)
 }
 
 // BuildGrpcLogExporter returns a Builder that creates an OTLP log exporter using
 // gRPC transport. The exporter sends log records to an OTLP-compatible collector
 // over the provided gRPC connection.
 func BuildGrpcLogExporter(grpcConnB bedrock.Builder[*grpc.ClientConn]) bedrock.BuilderFunc[*otlploggrpc.Exporter] {
-	return func(ctx context.Context) (*otlploggrpc.Exporter, error) {
-		return otlploggrpc.New(
+	return bedrock.BuilderFunc[*otlploggrpc.Exporter](bedrock.Named("OtlpGrpcLogExporter", bedrock.BuilderFunc[*otlploggrpc.Exporter](func(ctx context.Context) (*otlploggrpc.Exporter, error) {
+		conn, err := grpcConnB.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpGrpcLogExporter", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		exporter, err := otlploggrpc.New(
 			ctx,
-			otlploggrpc.WithGRPCConn(bedrock.MustBuild(ctx, grpcConnB)),
+			otlploggrpc.WithGRPCConn(conn),
 		)
-	}
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpGrpcLogExporter", err)
+		}
+		shutdown.OnCleanup(ctx, exporter)
+
+		return exporter, nil
+	})).Build)
 }
 
 // BuildHttpLogExporter returns a Builder that creates an OTLP log exporter using
@@ -97,11 +178,30 @@ func BuildHttpLogExporter(
 	endpoint config.Reader[string],
 	httpClientB bedrock.Builder[*http.Client],
 ) bedrock.BuilderFunc[*otlploghttp.Exporter] {
-	return func(ctx context.Context) (*otlploghttp.Exporter, error) {
-		return otlploghttp.New(
+	return bedrock.BuilderFunc[*otlploghttp.Exporter](bedrock.Named("OtlpHttpLogExporter", bedrock.BuilderFunc[*otlploghttp.Exporter](func(ctx context.Context) (*otlploghttp.Exporter, error) {
+		ep, err := bedrock.ReadConfig(ctx, "endpoint", endpoint)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpHttpLogExporter", err)
+		}
+
+		client, err := httpClientB.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpHttpLogExporter", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		exporter, err := otlploghttp.New(
 			ctx,
-			otlploghttp.WithEndpoint(config.Must(ctx, endpoint)),
-			otlploghttp.WithHTTPClient(bedrock.MustBuild(ctx, httpClientB)),
+			otlploghttp.WithEndpoint(ep),
+			otlploghttp.WithHTTPClient(client),
 		)
-	}
+		if err != nil {
+			return nil, bedrock.WrapBuildError("OtlpHttpLogExporter", err)
+		}
+		shutdown.OnCleanup(ctx, exporter)
+
+		return exporter, nil
+	})).Build)
 }
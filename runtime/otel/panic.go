@@ -0,0 +1,20 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package otel
+
+import (
+	"context"
+
+	"github.com/z5labs/bedrock/internal/otelpanic"
+)
+
+// RecordPanic records err on the span in ctx and emits it as an error log record
+// using the global LoggerProvider, if err is or wraps a *bedrock.PanicError. The
+// recovered value and stack trace are recorded using the OpenTelemetry exception
+// attributes. RecordPanic reports whether err was a panic.
+func RecordPanic(ctx context.Context, err error) bool {
+	return otelpanic.Record(ctx, err)
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// recordingLogExporter keeps every exported log record.
+type recordingLogExporter struct {
+	mu      sync.Mutex
+	records []sdklog.Record
+}
+
+func (e *recordingLogExporter) Export(ctx context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, r := range records {
+		e.records = append(e.records, r.Clone())
+	}
+	return nil
+}
+
+func (e *recordingLogExporter) Shutdown(ctx context.Context) error { return nil }
+
+func (e *recordingLogExporter) ForceFlush(ctx context.Context) error { return nil }
+
+func recoverPanic(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = bedrock.NewPanicError(r)
+		}
+	}()
+	f()
+	return nil
+}
+
+func TestRecordPanic(t *testing.T) {
+	t.Run("ignores errors which are not panics", func(t *testing.T) {
+		require.False(t, RecordPanic(context.Background(), errors.New("failed")))
+	})
+
+	t.Run("records the panic on the span and log pipeline", func(t *testing.T) {
+		spans := tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))
+
+		exporter := &recordingLogExporter{}
+		lp := sdklog.NewLoggerProvider(sdklog.WithProcessor(sdklog.NewSimpleProcessor(exporter)))
+
+		prev := global.GetLoggerProvider()
+		global.SetLoggerProvider(lp)
+		defer global.SetLoggerProvider(prev)
+
+		ctx, span := tp.Tracer("test").Start(context.Background(), "request")
+
+		err := recoverPanic(func() {
+			panic("boom")
+		})
+		require.True(t, RecordPanic(ctx, err))
+		span.End()
+
+		ended := spans.Ended()
+		require.Len(t, ended, 1)
+		require.Equal(t, codes.Error, ended[0].Status().Code)
+
+		events := ended[0].Events()
+		require.Len(t, events, 1)
+
+		attrs := make(map[string]string)
+		for _, attr := range events[0].Attributes {
+			attrs[string(attr.Key)] = attr.Value.Emit()
+		}
+		require.Equal(t, "string", attrs["exception.type"])
+		require.Equal(t, "boom", attrs["exception.message"])
+		require.Contains(t, attrs["exception.stacktrace"], "otel.TestRecordPanic")
+
+		require.Len(t, exporter.records, 1)
+		rec := exporter.records[0]
+		require.Equal(t, log.SeverityError, rec.Severity())
+		require.Equal(t, "recovered from panic: boom", rec.Body().AsString())
+
+		logAttrs := make(map[string]string)
+		rec.WalkAttributes(func(kv attribute.KeyValue) bool {
+			logAttrs[string(kv.Key)] = kv.Value.AsString()
+			return true
+		})
+		require.Equal(t, "boom", logAttrs["exception.message"])
+		require.Contains(t, logAttrs["exception.stacktrace"], "otel.TestRecordPanic")
+	})
+}
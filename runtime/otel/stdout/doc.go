@@ -18,4 +18,7 @@
 // These exporters are useful for local development, debugging, and testing
 // where telemetry output to stdout or a file is desired instead of sending
 // to a remote collector.
+//
+// In dry run mode, see bedrock.DryRun, the builders read their configuration and
+// build their dependencies but create no exporters.
 package stdout
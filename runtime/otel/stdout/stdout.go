@@ -10,6 +10,7 @@ import (
 	"io"
 
 	"github.com/z5labs/bedrock"
+	"github.com/z5labs/bedrock/runtime/otel/internal/shutdown"
 
 	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
 	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
@@ -20,29 +21,71 @@ import (
 // BuildSpanExporter returns a Builder that creates a span exporter which writes
 // trace data to the provided io.Writer in a human-readable format.
 func BuildSpanExporter[W io.Writer](writerB bedrock.Builder[W]) bedrock.BuilderFunc[*stdouttrace.Exporter] {
-	return func(ctx context.Context) (*stdouttrace.Exporter, error) {
-		return stdouttrace.New(
-			stdouttrace.WithWriter(bedrock.MustBuild(ctx, writerB)),
+	return bedrock.BuilderFunc[*stdouttrace.Exporter](bedrock.Named("StdoutSpanExporter", bedrock.BuilderFunc[*stdouttrace.Exporter](func(ctx context.Context) (*stdouttrace.Exporter, error) {
+		w, err := writerB.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("StdoutSpanExporter", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		exporter, err := stdouttrace.New(
+			stdouttrace.WithWriter(w),
 		)
-	}
+		if err != nil {
+			return nil, bedrock.WrapBuildError("StdoutSpanExporter", err)
+		}
+		shutdown.OnCleanup(ctx, exporter)
+
+		return exporter, nil
+	})).Build)
 }
 
 // BuildMetricExporter returns a Builder that creates a metric exporter which writes
 // metric data to the provided io.Writer in a human-readable format.
 func BuildMetricExporter[W io.Writer](writerB bedrock.Builder[W]) bedrock.BuilderFunc[metric.Exporter] {
-	return func(ctx context.Context) (metric.Exporter, error) {
-		return stdoutmetric.New(
-			stdoutmetric.WithWriter(bedrock.MustBuild(ctx, writerB)),
+	return bedrock.BuilderFunc[metric.Exporter](bedrock.Named("StdoutMetricExporter", bedrock.BuilderFunc[metric.Exporter](func(ctx context.Context) (metric.Exporter, error) {
+		w, err := writerB.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("StdoutMetricExporter", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		exporter, err := stdoutmetric.New(
+			stdoutmetric.WithWriter(w),
 		)
-	}
+		if err != nil {
+			return nil, bedrock.WrapBuildError("StdoutMetricExporter", err)
+		}
+		shutdown.OnCleanup(ctx, exporter)
+
+		return exporter, nil
+	})).Build)
 }
 
 // BuildLogExporter returns a Builder that creates a log exporter which writes
 // log records to the provided io.Writer in a human-readable format.
 func BuildLogExporter[W io.Writer](writerB bedrock.Builder[W]) bedrock.BuilderFunc[*stdoutlog.Exporter] {
-	return func(ctx context.Context) (*stdoutlog.Exporter, error) {
-		return stdoutlog.New(
-			stdoutlog.WithWriter(bedrock.MustBuild(ctx, writerB)),
+	return bedrock.BuilderFunc[*stdoutlog.Exporter](bedrock.Named("StdoutLogExporter", bedrock.BuilderFunc[*stdoutlog.Exporter](func(ctx context.Context) (*stdoutlog.Exporter, error) {
+		w, err := writerB.Build(ctx)
+		if err != nil {
+			return nil, bedrock.WrapBuildError("StdoutLogExporter", err)
+		}
+		if bedrock.DryRun(ctx) {
+			return nil, nil
+		}
+
+		exporter, err := stdoutlog.New(
+			stdoutlog.WithWriter(w),
 		)
-	}
+		if err != nil {
+			return nil, bedrock.WrapBuildError("StdoutLogExporter", err)
+		}
+		shutdown.OnCleanup(ctx, exporter)
+
+		return exporter, nil
+	})).Build)
 }
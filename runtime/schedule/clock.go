@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package schedule
+
+import "time"
+
+// Clock tells the time and creates timers for the scheduler. Tests can replace
+// it, see [WithClock], to control time without sleeping.
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single event created by a [Clock], like a [time.Timer].
+type Timer interface {
+	// C returns the channel the current time is sent on once the timer fires.
+	C() <-chan time.Time
+
+	// Stop prevents the timer from firing. It reports whether the timer was
+	// stopped before it fired.
+	Stop() bool
+}
+
+// systemClock is the Clock backed by the time package.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{t: time.NewTimer(d)}
+}
+
+type systemTimer struct {
+	t *time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.t.C
+}
+
+func (t systemTimer) Stop() bool {
+	return t.t.Stop()
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule reports when a job runs.
+type Schedule interface {
+	// Next returns the first time strictly after t at which the job runs, in the
+	// location of t, or the zero time if the job never runs again.
+	Next(t time.Time) time.Time
+}
+
+// interval runs a job at a fixed interval.
+type interval time.Duration
+
+func (d interval) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(d))
+}
+
+// cronField describes one of the five fields of a cron expression.
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteField = cronField{name: "minute", min: 0, max: 59}
+	hourField   = cronField{name: "hour", min: 0, max: 23}
+	domField    = cronField{name: "day of month", min: 1, max: 31}
+	monthField  = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	dowField = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronSchedule is a parsed cron expression. Each field is a bit set of the
+// values it matches.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+
+	// domStar and dowStar record whether the day fields start with "*", in which
+	// case a day matches only if both fields match rather than either of them.
+	domStar, dowStar bool
+}
+
+// ParseCron parses a standard five field cron expression, "minute hour day-of-month
+// month day-of-week". Each field is either "*" or a comma separated list of values,
+// ranges such as "1-5" and steps such as "*/15" or "0-30/10". Months and days of the
+// week may be given by their three letter English names, and both 0 and 7 are Sunday.
+// The descriptors @yearly, @annually, @monthly, @weekly, @daily, @midnight and
+// @hourly are also accepted.
+//
+// As with cron, if both day fields are restricted a day matches when either of
+// them does. The returned Schedule is evaluated in the location of the time given to
+// Next, and runs scheduled within a skipped daylight saving time hour do not happen.
+func ParseCron(expr string) (Schedule, error) {
+	spec := strings.TrimSpace(expr)
+	if d, ok := cronDescriptors[strings.ToLower(spec)]; ok {
+		spec = d
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("schedule: invalid cron expression %q: expected 5 fields, found %d", expr, len(fields))
+	}
+
+	var (
+		s   cronSchedule
+		err error
+	)
+	parsed := []struct {
+		field cronField
+		bits  *uint64
+	}{
+		{minuteField, &s.minute},
+		{hourField, &s.hour},
+		{domField, &s.dom},
+		{monthField, &s.month},
+		{dowField, &s.dow},
+	}
+	for i, p := range parsed {
+		*p.bits, err = p.field.parse(fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("schedule: invalid cron expression %q: %w", expr, err)
+		}
+	}
+
+	// Sunday may be given as either 0 or 7.
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+	s.domStar = strings.HasPrefix(fields[2], "*")
+	s.dowStar = strings.HasPrefix(fields[4], "*")
+	return &s, nil
+}
+
+func (f cronField) parse(s string) (uint64, error) {
+	var bits uint64
+	for part := range strings.SplitSeq(s, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepStr)
+			if err != nil || step <= 0 {
+				return 0, fmt.Errorf("%s field: invalid step %q", f.name, stepStr)
+			}
+		}
+
+		lo, hi := f.min, f.max
+		if rng != "*" {
+			loStr, hiStr, isRange := strings.Cut(rng, "-")
+
+			var err error
+			lo, err = f.value(loStr)
+			if err != nil {
+				return 0, err
+			}
+
+			switch {
+			case isRange:
+				hi, err = f.value(hiStr)
+				if err != nil {
+					return 0, err
+				}
+			case !hasStep:
+				hi = lo
+			}
+		}
+		if lo > hi {
+			return 0, fmt.Errorf("%s field: invalid range %q", f.name, rng)
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%s field: invalid value %q", f.name, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s field: value %d out of range [%d, %d]", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// cronSearchLimit bounds how far ahead Next searches for a matching time, so that
+// expressions which never match, such as "0 0 30 2 *", do not search forever.
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// Next implements the [Schedule] interface.
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	limit := t.Add(cronSearchLimit)
+
+	// Every location in use today has an offset of a whole number of minutes,
+	// so truncating the absolute time also truncates the wall clock time.
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	// advance moves t to the start of the next month, day or hour. Creating times
+	// from their wall clock time is ambiguous around daylight saving time changes,
+	// so t is moved a minute at a time if that would not move it forwards.
+	advance := func(next time.Time) {
+		if next.After(t) {
+			t = next
+			return
+		}
+		t = t.Add(time.Minute)
+	}
+
+	for t.Before(limit) {
+		switch {
+		case s.month&(1<<int(t.Month())) == 0:
+			advance(time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc))
+		case !s.dayMatches(t):
+			advance(time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc))
+		case s.hour&(1<<t.Hour()) == 0:
+			advance(time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc))
+		case s.minute&(1<<t.Minute()) == 0:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	dom := s.dom&(1<<t.Day()) != 0
+	dow := s.dow&(1<<int(t.Weekday())) != 0
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+	return dom || dow
+}
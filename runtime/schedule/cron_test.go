@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	testCases := []struct {
+		name string
+		expr string
+		from time.Time
+		want []time.Time
+	}{
+		{
+			name: "every minute",
+			expr: "* * * * *",
+			from: time.Date(2026, 3, 10, 12, 30, 15, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 3, 10, 12, 31, 0, 0, time.UTC),
+				time.Date(2026, 3, 10, 12, 32, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "steps",
+			expr: "*/15 * * * *",
+			from: time.Date(2026, 3, 10, 12, 30, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 3, 10, 12, 45, 0, 0, time.UTC),
+				time.Date(2026, 3, 10, 13, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "ranges and lists",
+			expr: "0 9-10,17 * * *",
+			from: time.Date(2026, 3, 10, 10, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 3, 10, 17, 0, 0, 0, time.UTC),
+				time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "names",
+			expr: "30 8 * jan-feb mon-fri",
+			from: time.Date(2026, 2, 27, 9, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2027, 1, 1, 8, 30, 0, 0, time.UTC),
+				time.Date(2027, 1, 4, 8, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "sunday as 7",
+			expr: "0 0 * * 7",
+			from: time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "either day field matches when both are restricted",
+			expr: "0 0 1 * mon",
+			from: time.Date(2026, 3, 24, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2026, 3, 30, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2026, 4, 6, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "descriptor",
+			expr: "@monthly",
+			from: time.Date(2026, 12, 15, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2027, 2, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "evaluated in the location of the time",
+			expr: "0 9 * * *",
+			from: time.Date(2026, 3, 10, 8, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)),
+			want: []time.Time{
+				time.Date(2026, 3, 10, 9, 0, 0, 0, time.FixedZone("UTC+2", 2*60*60)),
+			},
+		},
+		{
+			name: "leap day",
+			expr: "0 0 29 2 *",
+			from: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{
+				time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "never",
+			expr: "0 0 30 2 *",
+			from: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			want: []time.Time{{}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := ParseCron(tc.expr)
+			require.NoError(t, err)
+
+			next := tc.from
+			for _, want := range tc.want {
+				next = s.Next(next)
+				require.True(t, want.Equal(next), "expected %v, got %v", want, next)
+			}
+		})
+	}
+}
+
+func TestParseCron_Invalid(t *testing.T) {
+	testCases := []struct {
+		name string
+		expr string
+		err  string
+	}{
+		{name: "too few fields", expr: "* * * *", err: "expected 5 fields, found 4"},
+		{name: "out of range", expr: "60 * * * *", err: "minute field: value 60 out of range [0, 59]"},
+		{name: "invalid value", expr: "* * * foo *", err: `month field: invalid value "foo"`},
+		{name: "invalid step", expr: "*/0 * * * *", err: `minute field: invalid step "0"`},
+		{name: "inverted range", expr: "* 10-5 * * *", err: `hour field: invalid range "10-5"`},
+		{name: "unknown descriptor", expr: "@fortnightly", err: "expected 5 fields, found 1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseCron(tc.expr)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package schedule provides a bedrock Runtime which runs jobs on a schedule.
+//
+// Each job is a bedrock.Runtime which is run whenever its Trigger fires, either
+// according to a cron expression or at a fixed interval. Both are read from a
+// config.Reader when the Runtime is built:
+//
+//	rt := schedule.Build([]schedule.Job{
+//	    schedule.NewJob("cleanup", cleanup, schedule.Every(config.ReaderOf(5*time.Minute))),
+//	    schedule.NewJob(
+//	        "report",
+//	        report,
+//	        schedule.Cron(config.Env("REPORT_SCHEDULE")),
+//	        schedule.Location(config.ReaderOf(time.UTC)),
+//	        schedule.Timeout(config.ReaderOf(10*time.Minute)),
+//	    ),
+//	})
+//
+// # Job Options
+//
+//   - Jitter: Delays each run by a random duration, spreading load across instances
+//   - Timeout: Cancels the context passed to a run once it has taken too long
+//   - Location: Sets the time zone cron expressions are evaluated in
+//   - Overlap: Skips, queues or allows runs which are due while a previous run is in progress
+//   - CatchUp: Makes up for runs missed while the scheduler was behind
+//
+// # Shutdown
+//
+// Once the context passed to the Runtime is cancelled, no more runs are started and
+// the Runtime waits for the runs in progress to return. They are only cancelled if they
+// are still running once the deadline of bedrock.ShutdownContext passes or, when not run
+// by bedrock.GracefulShutdown, once the DrainTimeout passes.
+//
+// # Observability
+//
+// Every run is recorded as a span named after the job, and counted by the
+// bedrock.schedule.runs metric with its outcome: success, failure or skipped. The
+// bedrock.schedule.run.duration metric records how long each run took. Failed runs
+// may additionally be reported with OnError.
+//
+// # Testing
+//
+// The Runtime reads the time from a Clock, which tests can replace with WithClock
+// to run jobs by moving time forward rather than sleeping.
+package schedule
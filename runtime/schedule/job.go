@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/z5labs/bedrock"
+	"github.com/z5labs/bedrock/config"
+)
+
+// Trigger determines when a job runs, see [Cron] and [Every].
+type Trigger struct {
+	key      string
+	schedule config.Reader[Schedule]
+}
+
+// Cron returns a Trigger which runs a job according to a cron expression,
+// see [ParseCron].
+func Cron(expr config.Reader[string]) Trigger {
+	return Trigger{
+		key: "Cron",
+		schedule: config.Map(expr, func(_ context.Context, expr string) (Schedule, error) {
+			return ParseCron(expr)
+		}),
+	}
+}
+
+// Every returns a Trigger which runs a job at a fixed interval, starting one
+// interval after the Runtime starts.
+func Every(d config.Reader[time.Duration]) Trigger {
+	return Trigger{
+		key: "Interval",
+		schedule: config.Map(d, func(_ context.Context, d time.Duration) (Schedule, error) {
+			if d <= 0 {
+				return nil, errors.New("schedule: interval must be positive")
+			}
+			return interval(d), nil
+		}),
+	}
+}
+
+// OverlapPolicy decides what happens when a job is due while a previous run
+// of it is still in progress.
+type OverlapPolicy int
+
+const (
+	// SkipIfRunning skips the run. This is the default.
+	SkipIfRunning OverlapPolicy = iota
+
+	// QueueIfRunning starts the run once the previous runs have returned.
+	QueueIfRunning
+
+	// AllowConcurrent starts the run alongside the previous runs.
+	AllowConcurrent
+)
+
+// Job is a named task run by a [Runtime] according to a [Trigger].
+type Job struct {
+	name     string
+	task     bedrock.Runtime
+	trigger  Trigger
+	jitter   config.Reader[time.Duration]
+	timeout  config.Reader[time.Duration]
+	location config.Reader[*time.Location]
+	overlap  OverlapPolicy
+	catchUp  int
+}
+
+// JobOption configures a Job.
+type JobOption func(*Job)
+
+// Jitter delays each run by a random duration of up to d, which spreads out jobs
+// sharing the same schedule across many instances. The default is no jitter.
+func Jitter(d config.Reader[time.Duration]) JobOption {
+	return func(j *Job) {
+		j.jitter = d
+	}
+}
+
+// Timeout bounds how long a single run may take by cancelling the context passed
+// to the task once d has passed. The default is no timeout.
+func Timeout(d config.Reader[time.Duration]) JobOption {
+	return func(j *Job) {
+		j.timeout = d
+	}
+}
+
+// Location sets the time zone cron expressions are evaluated in.
+// The default is the local time zone.
+func Location(loc config.Reader[*time.Location]) JobOption {
+	return func(j *Job) {
+		j.location = loc
+	}
+}
+
+// Overlap sets what happens when a job is due while a previous run of it is
+// still in progress. The default is [SkipIfRunning].
+func Overlap(p OverlapPolicy) JobOption {
+	return func(j *Job) {
+		j.overlap = p
+	}
+}
+
+// CatchUp sets how many missed runs are made up for when the scheduler falls
+// behind, e.g. because the host was suspended. A job which is late always runs
+// once, followed by up to n of the runs it missed in the meantime. The missed runs
+// are subject to the overlap policy, so they are usually combined with [QueueIfRunning].
+// The default is 0, which skips every missed run.
+func CatchUp(n int) JobOption {
+	return func(j *Job) {
+		j.catchUp = n
+	}
+}
+
+// NewJob returns a Job which runs task under the given name whenever trigger fires.
+// Errors returned by task are reported, see [OnError], but do not stop the Runtime.
+func NewJob(name string, task bedrock.Runtime, trigger Trigger, opts ...JobOption) Job {
+	j := Job{
+		name:    name,
+		task:    task,
+		trigger: trigger,
+	}
+	for _, opt := range opts {
+		opt(&j)
+	}
+	return j
+}
+
+// build reads the configuration of the job.
+func (j Job) build(ctx context.Context) (*job, error) {
+	s, err := bedrock.ReadConfig(ctx, j.trigger.key, j.trigger.schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	jitter, err := readOr(ctx, "Jitter", 0, j.jitter)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout, err := readOr(ctx, "Timeout", 0, j.timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, err := readOr(ctx, "Location", time.Local, j.location)
+	if err != nil {
+		return nil, err
+	}
+	if loc == nil {
+		loc = time.Local
+	}
+
+	return &job{
+		name:     j.name,
+		task:     j.task,
+		schedule: s,
+		jitter:   jitter,
+		timeout:  timeout,
+		location: loc,
+		overlap:  j.overlap,
+		catchUp:  max(j.catchUp, 0),
+	}, nil
+}
+
+// readOr reads the job setting named key from r, returning def if r is nil
+// or does not have a value set.
+func readOr[T any](ctx context.Context, key string, def T, r config.Reader[T]) (T, error) {
+	if r == nil {
+		return def, nil
+	}
+
+	return bedrock.ReadConfig(ctx, key, config.Default(def, r))
+}
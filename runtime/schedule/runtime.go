@@ -0,0 +1,352 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/z5labs/bedrock"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/z5labs/bedrock/runtime/schedule"
+
+// Option configures the Runtime built by [Build].
+type Option func(*options)
+
+type options struct {
+	clock          Clock
+	tracerProvider trace.TracerProvider
+	meterProvider  metric.MeterProvider
+	onError        func(ctx context.Context, job string, err error)
+	drainTimeout   time.Duration
+}
+
+// WithClock sets the Clock used to schedule jobs. The default is the system clock.
+func WithClock(c Clock) Option {
+	return func(o *options) {
+		o.clock = c
+	}
+}
+
+// TracerProvider sets the TracerProvider used to create a span for every run.
+//
+// Default is the global TracerProvider.
+func TracerProvider(tp trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracerProvider = tp
+	}
+}
+
+// MeterProvider sets the MeterProvider used to record the outcome and duration
+// of every run.
+//
+// Default is the global MeterProvider.
+func MeterProvider(mp metric.MeterProvider) Option {
+	return func(o *options) {
+		o.meterProvider = mp
+	}
+}
+
+// OnError sets a function which is called whenever a run of a job fails.
+// By default, failures are only recorded in the span and metrics of the run.
+func OnError(f func(ctx context.Context, job string, err error)) Option {
+	return func(o *options) {
+		o.onError = f
+	}
+}
+
+// DrainTimeout bounds how long Run waits for the runs in progress to return once
+// its context is cancelled, when it is not run by bedrock.GracefulShutdown, which
+// sets its own shutdown deadline instead.
+//
+// Default is 30 seconds.
+func DrainTimeout(d time.Duration) Option {
+	return func(o *options) {
+		o.drainTimeout = d
+	}
+}
+
+// job is a Job whose configuration has been read, along with the state of its runs.
+type job struct {
+	name     string
+	task     bedrock.Runtime
+	schedule Schedule
+	jitter   time.Duration
+	timeout  time.Duration
+	location *time.Location
+	overlap  OverlapPolicy
+	catchUp  int
+
+	mu       sync.Mutex
+	running  int
+	queued   []time.Time
+	inflight sync.WaitGroup
+}
+
+// next returns the first time after t at which j runs.
+func (j *job) next(t time.Time) time.Time {
+	return j.schedule.Next(t.In(j.location))
+}
+
+// Runtime runs jobs according to their schedules until its context is cancelled.
+type Runtime struct {
+	jobs    []*job
+	clock   Clock
+	tracer  trace.Tracer
+	runs    metric.Int64Counter
+	latency metric.Float64Histogram
+	onError func(ctx context.Context, job string, err error)
+
+	drainTimeout time.Duration
+}
+
+// Build creates a bedrock.Builder that constructs a Runtime running the given jobs.
+//
+// The configuration of every job is read when the Runtime is built. Errors from
+// reading it are returned as a *bedrock.BuildError naming the job.
+func Build(jobs []Job, opts ...Option) bedrock.Builder[Runtime] {
+	return bedrock.Named("schedule.Runtime", bedrock.BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+		o := options{
+			clock:          systemClock{},
+			tracerProvider: otel.GetTracerProvider(),
+			meterProvider:  otel.GetMeterProvider(),
+			onError:        func(context.Context, string, error) {},
+			// 30 seconds aligns with the K8s default terminationGracePeriodSeconds
+			drainTimeout: 30 * time.Second,
+		}
+		for _, opt := range opts {
+			opt(&o)
+		}
+
+		rt := Runtime{
+			clock:   o.clock,
+			tracer:  o.tracerProvider.Tracer(instrumentationName),
+			onError: o.onError,
+
+			drainTimeout: o.drainTimeout,
+		}
+
+		names := make(map[string]struct{}, len(jobs))
+		for _, j := range jobs {
+			if _, ok := names[j.name]; ok {
+				return Runtime{}, bedrock.WrapBuildError("schedule.Runtime", fmt.Errorf("schedule: duplicate job name %q", j.name))
+			}
+			names[j.name] = struct{}{}
+
+			built, err := j.build(ctx)
+			if err != nil {
+				return Runtime{}, bedrock.WrapBuildError("schedule.Runtime", bedrock.WrapBuildError(j.name, err))
+			}
+			rt.jobs = append(rt.jobs, built)
+		}
+
+		meter := o.meterProvider.Meter(instrumentationName)
+
+		var err error
+		rt.runs, err = meter.Int64Counter(
+			"bedrock.schedule.runs",
+			metric.WithDescription("Number of scheduled job runs, by outcome."),
+		)
+		if err != nil {
+			return Runtime{}, bedrock.WrapBuildError("schedule.Runtime", err)
+		}
+
+		rt.latency, err = meter.Float64Histogram(
+			"bedrock.schedule.run.duration",
+			metric.WithDescription("Duration of scheduled job runs."),
+			metric.WithUnit("s"),
+		)
+		if err != nil {
+			return Runtime{}, bedrock.WrapBuildError("schedule.Runtime", err)
+		}
+
+		return rt, nil
+	}))
+}
+
+// Run schedules every job and blocks until the context is cancelled.
+//
+// Once the context is cancelled no more runs are started, including queued ones,
+// and Run waits for the runs in progress to return. They are passed a context which
+// is only cancelled once bedrock.ShutdownContext expires, or the drain timeout passes
+// if it has no deadline, see DrainTimeout, or when their own timeout passes. Run
+// returns the cause of the runs being cancelled, if they are.
+//
+// Run reports that it is ready, see bedrock.Ready, once every job is scheduled.
+func (r Runtime) Run(ctx context.Context) error {
+	runCtx, cancelRuns := context.WithCancel(context.WithoutCancel(ctx))
+	defer cancelRuns()
+
+	var loops sync.WaitGroup
+	for _, j := range r.jobs {
+		loops.Go(func() {
+			r.loop(ctx, runCtx, j)
+		})
+	}
+	bedrock.Ready(ctx)
+
+	<-ctx.Done()
+	loops.Wait()
+
+	shutdownCtx, cancel := bedrock.ShutdownContext(ctx)
+	defer cancel()
+	if _, ok := shutdownCtx.Deadline(); !ok {
+		var cancelDrain context.CancelFunc
+		shutdownCtx, cancelDrain = context.WithTimeoutCause(shutdownCtx, r.drainTimeout, bedrock.ErrShutdownTimeout)
+		defer cancelDrain()
+	}
+
+	stop := context.AfterFunc(shutdownCtx, cancelRuns)
+	defer stop()
+
+	for _, j := range r.jobs {
+		j.inflight.Wait()
+	}
+	if shutdownCtx.Err() != nil {
+		return context.Cause(shutdownCtx)
+	}
+	return nil
+}
+
+// loop starts the runs of j until ctx is cancelled or j is never due again.
+func (r Runtime) loop(ctx, runCtx context.Context, j *job) {
+	now := r.clock.Now()
+	next := j.next(now)
+	for !next.IsZero() {
+		var jitter time.Duration
+		if j.jitter > 0 {
+			jitter = rand.N(j.jitter)
+		}
+
+		timer := r.clock.NewTimer(next.Sub(now) + jitter)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C():
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		// Runs which were due by the time the timer fired have been missed.
+		now = r.clock.Now()
+		cutoff := now.Add(-jitter)
+		due := []time.Time{next}
+		next = j.next(next)
+		for !next.IsZero() && !next.After(cutoff) {
+			if len(due) > j.catchUp {
+				next = j.next(cutoff)
+				break
+			}
+			due = append(due, next)
+			next = j.next(next)
+		}
+
+		for _, scheduled := range due {
+			r.start(ctx, runCtx, j, scheduled)
+		}
+	}
+}
+
+// start runs j according to its overlap policy. Queued runs are dropped once
+// ctx is cancelled.
+func (r Runtime) start(ctx, runCtx context.Context, j *job, scheduled time.Time) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.running > 0 {
+		switch j.overlap {
+		case SkipIfRunning:
+			r.runs.Add(runCtx, 1, metric.WithAttributes(
+				attribute.String("job", j.name),
+				attribute.String("outcome", "skipped"),
+			))
+			return
+		case QueueIfRunning:
+			j.queued = append(j.queued, scheduled)
+			return
+		}
+	}
+
+	j.running++
+	j.inflight.Go(func() {
+		for {
+			r.run(runCtx, j, scheduled)
+
+			j.mu.Lock()
+			if len(j.queued) == 0 || ctx.Err() != nil {
+				j.queued = nil
+				j.running--
+				j.mu.Unlock()
+				return
+			}
+			scheduled = j.queued[0]
+			j.queued = j.queued[1:]
+			j.mu.Unlock()
+		}
+	})
+}
+
+// run runs j once, recording its outcome.
+func (r Runtime) run(ctx context.Context, j *job, scheduled time.Time) {
+	if j.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, j.timeout)
+		defer cancel()
+	}
+
+	ctx, span := r.tracer.Start(
+		ctx,
+		j.name,
+		trace.WithNewRoot(),
+		trace.WithAttributes(
+			attribute.String("schedule.job", j.name),
+			attribute.String("schedule.scheduled_time", scheduled.Format(time.RFC3339)),
+		),
+	)
+	defer span.End()
+
+	start := r.clock.Now()
+	err := runTask(ctx, j.task)
+	duration := r.clock.Now().Sub(start)
+
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		r.onError(ctx, j.name, err)
+	}
+
+	attrs := metric.WithAttributes(
+		attribute.String("job", j.name),
+		attribute.String("outcome", outcome),
+	)
+	r.runs.Add(ctx, 1, attrs)
+	r.latency.Record(ctx, duration.Seconds(), attrs)
+}
+
+// runTask runs task, turning panics into errors.
+func runTask(ctx context.Context, task bedrock.Runtime) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = bedrock.NewPanicError(r)
+		}
+	}()
+
+	return task.Run(ctx)
+}
@@ -0,0 +1,607 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package schedule
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/z5labs/bedrock"
+	"github.com/z5labs/bedrock/config"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// fakeClock is a Clock which only moves when advanced.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	when time.Time
+	ch   chan time.Time
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := &fakeTimer{when: c.now.Add(d), ch: make(chan time.Time, 1)}
+	if d <= 0 {
+		t.ch <- c.now
+		return fakeTimerHandle{c: c, t: t}
+	}
+	c.timers = append(c.timers, t)
+	return fakeTimerHandle{c: c, t: t}
+}
+
+// Advance moves the clock forward by d, firing every timer which is due.
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+	pending := c.timers[:0]
+	for _, t := range c.timers {
+		if t.when.After(c.now) {
+			pending = append(pending, t)
+			continue
+		}
+		t.ch <- c.now
+	}
+	c.timers = pending
+}
+
+// WaitForTimers waits until n timers are pending and returns when they fire.
+func (c *fakeClock) WaitForTimers(t *testing.T, n int) []time.Time {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		c.mu.Lock()
+		if len(c.timers) == n {
+			whens := make([]time.Time, n)
+			for i, t := range c.timers {
+				whens[i] = t.when
+			}
+			c.mu.Unlock()
+			return whens
+		}
+		c.mu.Unlock()
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("expected %d pending timers", n)
+	return nil
+}
+
+type fakeTimerHandle struct {
+	c *fakeClock
+	t *fakeTimer
+}
+
+func (h fakeTimerHandle) C() <-chan time.Time {
+	return h.t.ch
+}
+
+func (h fakeTimerHandle) Stop() bool {
+	h.c.mu.Lock()
+	defer h.c.mu.Unlock()
+
+	for i, t := range h.c.timers {
+		if t == h.t {
+			h.c.timers = append(h.c.timers[:i], h.c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+var start = time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+// runScheduler builds and runs a Runtime in the background, stopping it once the test ends.
+func runScheduler(t *testing.T, jobs []Job, opts ...Option) (cancel func() error) {
+	t.Helper()
+
+	rt, err := Build(jobs, opts...).Build(context.Background())
+	require.NoError(t, err)
+
+	ctx, stop := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- rt.Run(ctx)
+	}()
+
+	var once sync.Once
+	var runErr error
+	cancel = func() error {
+		once.Do(func() {
+			stop()
+			select {
+			case runErr = <-errCh:
+			case <-time.After(5 * time.Second):
+				runErr = errors.New("runtime did not stop")
+			}
+		})
+		return runErr
+	}
+	t.Cleanup(func() {
+		cancel()
+	})
+	return cancel
+}
+
+// blockingTask signals on started each time it runs and returns once released.
+func blockingTask(started chan<- struct{}, release <-chan struct{}) bedrock.Runtime {
+	return bedrock.RuntimeFunc(func(ctx context.Context) error {
+		started <- struct{}{}
+		<-release
+		return nil
+	})
+}
+
+func receive(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-ch:
+	case <-time.After(5 * time.Second):
+		t.Fatal("job did not run")
+	}
+}
+
+func requireNotReceived(t *testing.T, ch <-chan struct{}) {
+	t.Helper()
+
+	select {
+	case <-ch:
+		t.Fatal("job ran unexpectedly")
+	case <-time.After(20 * time.Millisecond):
+	}
+}
+
+func TestRuntime_Schedules(t *testing.T) {
+	t.Run("runs a job at a fixed interval", func(t *testing.T) {
+		clock := newFakeClock(start)
+		ran := make(chan struct{}, 1)
+
+		runScheduler(t, []Job{
+			NewJob("sync", bedrock.RuntimeFunc(func(ctx context.Context) error {
+				ran <- struct{}{}
+				return nil
+			}), Every(config.ReaderOf(time.Minute))),
+		}, WithClock(clock))
+
+		for i := 1; i <= 3; i++ {
+			whens := clock.WaitForTimers(t, 1)
+			require.True(t, start.Add(time.Duration(i)*time.Minute).Equal(whens[0]))
+
+			clock.Advance(time.Minute)
+			receive(t, ran)
+		}
+	})
+
+	t.Run("runs a cron job in its time zone", func(t *testing.T) {
+		clock := newFakeClock(start)
+		ran := make(chan struct{}, 1)
+
+		runScheduler(t, []Job{
+			NewJob("report", bedrock.RuntimeFunc(func(ctx context.Context) error {
+				ran <- struct{}{}
+				return nil
+			}),
+				Cron(config.ReaderOf("0 9 * * *")),
+				Location(config.ReaderOf(time.FixedZone("UTC-5", -5*60*60))),
+			),
+		}, WithClock(clock))
+
+		whens := clock.WaitForTimers(t, 1)
+		require.True(t, time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC).Equal(whens[0]))
+
+		clock.Advance(2 * time.Hour)
+		receive(t, ran)
+
+		whens = clock.WaitForTimers(t, 1)
+		require.True(t, time.Date(2026, 3, 11, 14, 0, 0, 0, time.UTC).Equal(whens[0]))
+	})
+
+	t.Run("delays runs by up to the jitter", func(t *testing.T) {
+		clock := newFakeClock(start)
+
+		runScheduler(t, []Job{
+			NewJob("sync", bedrock.RuntimeFunc(func(ctx context.Context) error {
+				return nil
+			}),
+				Every(config.ReaderOf(time.Minute)),
+				Jitter(config.ReaderOf(10*time.Second)),
+			),
+		}, WithClock(clock))
+
+		whens := clock.WaitForTimers(t, 1)
+		require.False(t, whens[0].Before(start.Add(time.Minute)))
+		require.True(t, whens[0].Before(start.Add(time.Minute+10*time.Second)))
+	})
+
+	t.Run("catches up on missed runs", func(t *testing.T) {
+		testCases := []struct {
+			name    string
+			catchUp int
+			runs    int
+		}{
+			{name: "skips missed runs by default", catchUp: 0, runs: 1},
+			{name: "makes up for some missed runs", catchUp: 2, runs: 3},
+			{name: "makes up for every missed run", catchUp: 10, runs: 5},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				clock := newFakeClock(start)
+				var runs atomic.Int64
+
+				runScheduler(t, []Job{
+					NewJob("sync", bedrock.RuntimeFunc(func(ctx context.Context) error {
+						runs.Add(1)
+						return nil
+					}),
+						Every(config.ReaderOf(time.Minute)),
+						Overlap(AllowConcurrent),
+						CatchUp(tc.catchUp),
+					),
+				}, WithClock(clock))
+
+				clock.WaitForTimers(t, 1)
+				clock.Advance(5*time.Minute + 30*time.Second)
+				clock.WaitForTimers(t, 1)
+
+				require.Eventually(t, func() bool {
+					return runs.Load() == int64(tc.runs)
+				}, 5*time.Second, time.Millisecond)
+			})
+		}
+	})
+}
+
+func TestRuntime_Overlap(t *testing.T) {
+	t.Run("skips runs while the previous run is in progress", func(t *testing.T) {
+		clock := newFakeClock(start)
+		reader := sdkmetric.NewManualReader()
+		started := make(chan struct{}, 2)
+		release := make(chan struct{})
+
+		runScheduler(t, []Job{
+			NewJob("sync", blockingTask(started, release), Every(config.ReaderOf(time.Minute))),
+		}, WithClock(clock), MeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))))
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+		receive(t, started)
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+		clock.WaitForTimers(t, 1)
+		requireNotReceived(t, started)
+
+		require.Equal(t, map[string]int64{"skipped": 1}, runOutcomes(t, reader))
+		close(release)
+	})
+
+	t.Run("queues runs while the previous run is in progress", func(t *testing.T) {
+		clock := newFakeClock(start)
+		started := make(chan struct{}, 2)
+		release := make(chan struct{})
+
+		runScheduler(t, []Job{
+			NewJob("sync", blockingTask(started, release), Every(config.ReaderOf(time.Minute)), Overlap(QueueIfRunning)),
+		}, WithClock(clock))
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+		receive(t, started)
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+		clock.WaitForTimers(t, 1)
+		requireNotReceived(t, started)
+
+		release <- struct{}{}
+		receive(t, started)
+		close(release)
+	})
+
+	t.Run("allows concurrent runs", func(t *testing.T) {
+		clock := newFakeClock(start)
+		started := make(chan struct{}, 2)
+		release := make(chan struct{})
+		defer close(release)
+
+		runScheduler(t, []Job{
+			NewJob("sync", blockingTask(started, release), Every(config.ReaderOf(time.Minute)), Overlap(AllowConcurrent)),
+		}, WithClock(clock))
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+		receive(t, started)
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+		receive(t, started)
+	})
+}
+
+// runOutcomes returns how many runs were recorded for each outcome.
+func runOutcomes(t *testing.T, reader sdkmetric.Reader) map[string]int64 {
+	t.Helper()
+
+	var rm metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(context.Background(), &rm))
+
+	outcomes := make(map[string]int64)
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			if m.Name != "bedrock.schedule.runs" {
+				continue
+			}
+			for _, dp := range m.Data.(metricdata.Sum[int64]).DataPoints {
+				outcome, _ := dp.Attributes.Value(attribute.Key("outcome"))
+				outcomes[outcome.AsString()] += dp.Value
+			}
+		}
+	}
+	return outcomes
+}
+
+func TestRuntime_Runs(t *testing.T) {
+	t.Run("records a span and metrics for every run", func(t *testing.T) {
+		clock := newFakeClock(start)
+		recorder := tracetest.NewSpanRecorder()
+		reader := sdkmetric.NewManualReader()
+		jobErr := errors.New("failed")
+
+		var calls atomic.Int64
+		failures := make(chan error, 1)
+		runScheduler(t, []Job{
+			NewJob("sync", bedrock.RuntimeFunc(func(ctx context.Context) error {
+				if calls.Add(1) == 1 {
+					return nil
+				}
+				return jobErr
+			}), Every(config.ReaderOf(time.Minute))),
+		},
+			WithClock(clock),
+			TracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))),
+			MeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))),
+			OnError(func(ctx context.Context, job string, err error) {
+				require.Equal(t, "sync", job)
+				failures <- err
+			}),
+		)
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+		require.Eventually(t, func() bool {
+			return len(recorder.Ended()) == 1
+		}, 5*time.Second, time.Millisecond)
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+
+		select {
+		case err := <-failures:
+			require.Equal(t, jobErr, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("failure was not reported")
+		}
+
+		require.Eventually(t, func() bool {
+			return len(recorder.Ended()) == 2
+		}, 5*time.Second, time.Millisecond)
+
+		spans := recorder.Ended()
+		require.Equal(t, "sync", spans[0].Name())
+		require.Equal(t, codes.Unset, spans[0].Status().Code)
+		require.Equal(t, codes.Error, spans[1].Status().Code)
+		require.Contains(t, spans[1].Attributes(), attribute.String("schedule.scheduled_time", start.Add(2*time.Minute).Format(time.RFC3339)))
+
+		require.Equal(t, map[string]int64{"success": 1, "failure": 1}, runOutcomes(t, reader))
+	})
+
+	t.Run("recovers panics", func(t *testing.T) {
+		clock := newFakeClock(start)
+		failures := make(chan error, 1)
+
+		runScheduler(t, []Job{
+			NewJob("sync", bedrock.RuntimeFunc(func(ctx context.Context) error {
+				panic("boom")
+			}), Every(config.ReaderOf(time.Minute))),
+		}, WithClock(clock), OnError(func(ctx context.Context, job string, err error) {
+			failures <- err
+		}))
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+
+		var panicErr *bedrock.PanicError
+		require.ErrorAs(t, <-failures, &panicErr)
+	})
+
+	t.Run("cancels runs which exceed their timeout", func(t *testing.T) {
+		clock := newFakeClock(start)
+		failures := make(chan error, 1)
+
+		runScheduler(t, []Job{
+			NewJob("sync", bedrock.RuntimeFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			}), Every(config.ReaderOf(time.Minute)), Timeout(config.ReaderOf(10*time.Millisecond))),
+		}, WithClock(clock), OnError(func(ctx context.Context, job string, err error) {
+			failures <- err
+		}))
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+		require.ErrorIs(t, <-failures, context.DeadlineExceeded)
+	})
+}
+
+func TestRuntime_Stop(t *testing.T) {
+	t.Run("waits for runs in progress", func(t *testing.T) {
+		clock := newFakeClock(start)
+		started := make(chan struct{}, 1)
+		release := make(chan struct{})
+
+		var cancelled atomic.Bool
+		stop := runScheduler(t, []Job{
+			NewJob("sync", bedrock.RuntimeFunc(func(ctx context.Context) error {
+				started <- struct{}{}
+				<-release
+				cancelled.Store(ctx.Err() != nil)
+				return nil
+			}), Every(config.ReaderOf(time.Minute))),
+		}, WithClock(clock))
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+		receive(t, started)
+
+		stopped := make(chan error, 1)
+		go func() {
+			stopped <- stop()
+		}()
+
+		select {
+		case err := <-stopped:
+			t.Fatalf("runtime stopped before the run returned: %v", err)
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		close(release)
+		require.NoError(t, <-stopped)
+		require.False(t, cancelled.Load())
+	})
+
+	t.Run("cancels runs in progress at the shutdown deadline", func(t *testing.T) {
+		clock := newFakeClock(start)
+		started := make(chan struct{}, 1)
+
+		rt := Build([]Job{
+			NewJob("sync", bedrock.RuntimeFunc(func(ctx context.Context) error {
+				started <- struct{}{}
+				<-ctx.Done()
+				return ctx.Err()
+			}), Every(config.ReaderOf(time.Minute))),
+		}, WithClock(clock))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- bedrock.GracefulShutdown(bedrock.DefaultRunner[Runtime](), 50*time.Millisecond).Run(ctx, rt)
+		}()
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+		receive(t, started)
+
+		cancel()
+		require.ErrorIs(t, <-errCh, bedrock.ErrShutdownTimeout)
+	})
+
+	t.Run("cancels runs in progress after the drain timeout without GracefulShutdown", func(t *testing.T) {
+		clock := newFakeClock(start)
+		started := make(chan struct{}, 1)
+
+		stop := runScheduler(t, []Job{
+			NewJob("sync", bedrock.RuntimeFunc(func(ctx context.Context) error {
+				started <- struct{}{}
+				<-ctx.Done()
+				return ctx.Err()
+			}), Every(config.ReaderOf(time.Minute))),
+		}, WithClock(clock), DrainTimeout(50*time.Millisecond))
+
+		clock.WaitForTimers(t, 1)
+		clock.Advance(time.Minute)
+		receive(t, started)
+
+		require.ErrorIs(t, stop(), bedrock.ErrShutdownTimeout)
+	})
+
+	t.Run("reports that it is ready", func(t *testing.T) {
+		rt, err := Build(nil).Build(context.Background())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		ctx, ready := bedrock.NotifyReady(ctx)
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- rt.Run(ctx)
+		}()
+
+		<-ready
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+}
+
+func TestBuild(t *testing.T) {
+	noop := bedrock.RuntimeFunc(func(ctx context.Context) error {
+		return nil
+	})
+
+	t.Run("reports invalid cron expressions", func(t *testing.T) {
+		_, err := Build([]Job{
+			NewJob("report", noop, Cron(config.ReaderOf("0 25 * * *"))),
+		}).Build(context.Background())
+
+		var buildErr *bedrock.BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"schedule.Runtime", "report"}, buildErr.Path)
+		require.Equal(t, "Cron", buildErr.Key)
+		require.ErrorContains(t, err, "hour field: value 25 out of range [0, 23]")
+	})
+
+	t.Run("reports unset intervals", func(t *testing.T) {
+		_, err := Build([]Job{
+			NewJob("sync", noop, Every(config.EmptyReader[time.Duration]())),
+		}).Build(context.Background())
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+	})
+
+	t.Run("rejects non-positive intervals", func(t *testing.T) {
+		_, err := Build([]Job{
+			NewJob("sync", noop, Every(config.ReaderOf(time.Duration(0)))),
+		}).Build(context.Background())
+		require.ErrorContains(t, err, "interval must be positive")
+	})
+
+	t.Run("rejects duplicate job names", func(t *testing.T) {
+		_, err := Build([]Job{
+			NewJob("sync", noop, Every(config.ReaderOf(time.Minute))),
+			NewJob("sync", noop, Every(config.ReaderOf(time.Hour))),
+		}).Build(context.Background())
+		require.ErrorContains(t, err, `duplicate job name "sync"`)
+	})
+}
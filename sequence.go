@@ -0,0 +1,172 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrPhaseTimeout is returned, wrapped in a *PhaseError, when a phase of a
+// Sequence does not return within the timeout given with [PhaseTimeout].
+var ErrPhaseTimeout = errors.New("phase timed out")
+
+// PhaseError records which phase of a Sequence failed.
+type PhaseError struct {
+	// Name is the name of the phase given to [Phase].
+	Name string
+
+	// Err is the error returned by the phase.
+	Err error
+}
+
+// Error implements the [error] interface.
+func (e *PhaseError) Error() string {
+	return fmt.Sprintf("phase %q: %v", e.Name, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *PhaseError) Unwrap() error {
+	return e.Err
+}
+
+// SequencePhase is a named Runtime run as part of a [Sequence].
+type SequencePhase struct {
+	name     string
+	runtime  Runtime
+	timeout  time.Duration
+	postStop bool
+}
+
+// PhaseOption configures a SequencePhase.
+type PhaseOption func(*SequencePhase)
+
+// PhaseTimeout bounds how long a phase may run. The context passed to the phase is
+// cancelled once d has passed and, unless the phase returns nil anyway, the phase
+// fails with [ErrPhaseTimeout]. By default, a phase may run for as long as it likes.
+func PhaseTimeout(d time.Duration) PhaseOption {
+	return func(p *SequencePhase) {
+		p.timeout = d
+	}
+}
+
+// PostStop runs a phase once the other phases have finished, whether they
+// returned, failed or were stopped, e.g. to deregister from service discovery
+// or flush a cache after the server has stopped.
+func PostStop() PhaseOption {
+	return func(p *SequencePhase) {
+		p.postStop = true
+	}
+}
+
+// Phase returns a SequencePhase which runs rt under the given name.
+func Phase(name string, rt Runtime, opts ...PhaseOption) SequencePhase {
+	p := SequencePhase{
+		name:    name,
+		runtime: rt,
+	}
+	for _, opt := range opts {
+		opt(&p)
+	}
+	return p
+}
+
+// Sequence returns a Runtime which runs each phase in order, e.g. running schema
+// migrations and warming caches before serving requests:
+//
+//	rt := bedrock.Sequence(
+//		bedrock.Phase("migrate", migrations, bedrock.PhaseTimeout(5*time.Minute)),
+//		bedrock.Phase("warmup", cacheWarmer, bedrock.PhaseTimeout(30*time.Second)),
+//		bedrock.Phase("serve", server),
+//		bedrock.Phase("deregister", deregistration, bedrock.PostStop()),
+//	)
+//
+// Each phase is started once the previous one returns. The first phase to fail stops
+// the Sequence and its error is returned as a *PhaseError identifying the phase. Once
+// ctx is cancelled, the remaining phases are skipped and errors wrapping [context.Canceled]
+// returned by the running phase are ignored. Panics are recovered and reported as
+// failures of the phase.
+//
+// Phases marked with [PostStop] are instead run, in order, once the other phases have
+// finished, even if one of them failed or ctx was cancelled. Every post-stop phase is run
+// and their errors are joined with the error of the failed phase. Since ctx may already
+// be cancelled, they are passed a context from [ShutdownContext] in that case so that
+// they fit within the shutdown deadline.
+func Sequence(phases ...SequencePhase) Runtime {
+	return RuntimeFunc(func(ctx context.Context) error {
+		var errs []error
+		for _, p := range phases {
+			if p.postStop {
+				continue
+			}
+			if ctx.Err() != nil {
+				break
+			}
+
+			err := p.run(ctx)
+			if err != nil {
+				errs = append(errs, err)
+				break
+			}
+		}
+
+		stopCtx, cancel := postStopContext(ctx)
+		defer cancel()
+
+		for _, p := range phases {
+			if !p.postStop {
+				continue
+			}
+
+			err := p.run(stopCtx)
+			if err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// postStopContext returns the context passed to post-stop phases.
+func postStopContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if ctx.Err() != nil {
+		return ShutdownContext(ctx)
+	}
+	return context.WithCancel(context.WithoutCancel(ctx))
+}
+
+func (p SequencePhase) run(ctx context.Context) error {
+	runCtx := ctx
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		runCtx, cancel = context.WithTimeoutCause(ctx, p.timeout, ErrPhaseTimeout)
+		defer cancel()
+	}
+
+	err := p.runOnce(runCtx)
+	switch {
+	case err == nil:
+		return nil
+	case ctx.Err() != nil && errors.Is(err, context.Canceled):
+		return nil
+	case errors.Is(context.Cause(runCtx), ErrPhaseTimeout) && !errors.Is(err, ErrPhaseTimeout):
+		err = fmt.Errorf("%w: %w", ErrPhaseTimeout, err)
+	}
+	return &PhaseError{Name: p.name, Err: err}
+}
+
+// runOnce runs the phase, turning panics into errors.
+func (p SequencePhase) runOnce(ctx context.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = NewPanicError(r)
+		}
+	}()
+
+	return p.runtime.Run(ctx)
+}
@@ -0,0 +1,196 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// phaseRecorder records the order phases are run in.
+type phaseRecorder struct {
+	mu  sync.Mutex
+	ran []string
+}
+
+func (r *phaseRecorder) phase(name string, err error) Runtime {
+	return RuntimeFunc(func(ctx context.Context) error {
+		r.mu.Lock()
+		r.ran = append(r.ran, name)
+		r.mu.Unlock()
+		return err
+	})
+}
+
+func (r *phaseRecorder) Ran() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.ran
+}
+
+func TestSequence(t *testing.T) {
+	t.Run("runs each phase in order", func(t *testing.T) {
+		var rec phaseRecorder
+
+		err := Sequence(
+			Phase("migrate", rec.phase("migrate", nil)),
+			Phase("warmup", rec.phase("warmup", nil)),
+			Phase("serve", rec.phase("serve", nil)),
+		).Run(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []string{"migrate", "warmup", "serve"}, rec.Ran())
+	})
+
+	t.Run("stops at the first failure", func(t *testing.T) {
+		var rec phaseRecorder
+		migrateErr := errors.New("migration failed")
+
+		err := Sequence(
+			Phase("migrate", rec.phase("migrate", migrateErr)),
+			Phase("serve", rec.phase("serve", nil)),
+		).Run(context.Background())
+		require.ErrorIs(t, err, migrateErr)
+		require.Equal(t, `phase "migrate": migration failed`, err.Error())
+		require.Equal(t, []string{"migrate"}, rec.Ran())
+
+		var phaseErr *PhaseError
+		require.ErrorAs(t, err, &phaseErr)
+		require.Equal(t, "migrate", phaseErr.Name)
+	})
+
+	t.Run("fails a phase which exceeds its timeout", func(t *testing.T) {
+		var rec phaseRecorder
+
+		err := Sequence(
+			Phase("warmup", blockingRuntime(), PhaseTimeout(10*time.Millisecond)),
+			Phase("serve", rec.phase("serve", nil)),
+		).Run(context.Background())
+		require.ErrorIs(t, err, ErrPhaseTimeout)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Empty(t, rec.Ran())
+
+		var phaseErr *PhaseError
+		require.ErrorAs(t, err, &phaseErr)
+		require.Equal(t, "warmup", phaseErr.Name)
+	})
+
+	t.Run("does not fail a phase which returns nil after its timeout", func(t *testing.T) {
+		err := Sequence(
+			Phase("warmup", RuntimeFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return nil
+			}), PhaseTimeout(10*time.Millisecond)),
+		).Run(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("skips the remaining phases once cancelled", func(t *testing.T) {
+		var rec phaseRecorder
+		ctx, cancel := context.WithCancel(context.Background())
+
+		err := Sequence(
+			Phase("serve", RuntimeFunc(func(ctx context.Context) error {
+				cancel()
+				return ctx.Err()
+			})),
+			Phase("report", rec.phase("report", nil)),
+		).Run(ctx)
+		require.NoError(t, err)
+		require.Empty(t, rec.Ran())
+	})
+
+	t.Run("runs post-stop phases after cancellation", func(t *testing.T) {
+		var rec phaseRecorder
+		ctx, cancel := context.WithCancel(context.Background())
+
+		errCh := make(chan error, 1)
+		started := make(chan struct{})
+		go func() {
+			errCh <- Sequence(
+				Phase("serve", RuntimeFunc(func(ctx context.Context) error {
+					close(started)
+					<-ctx.Done()
+					return ctx.Err()
+				})),
+				Phase("deregister", RuntimeFunc(func(ctx context.Context) error {
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+					return rec.phase("deregister", nil).Run(ctx)
+				}), PostStop()),
+			).Run(ctx)
+		}()
+
+		<-started
+		cancel()
+
+		select {
+		case err := <-errCh:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Fatal("sequence did not stop")
+		}
+		require.Equal(t, []string{"deregister"}, rec.Ran())
+	})
+
+	t.Run("runs every post-stop phase even when earlier phases failed", func(t *testing.T) {
+		var rec phaseRecorder
+		serveErr := errors.New("bind failed")
+		flushErr := errors.New("flush failed")
+
+		err := Sequence(
+			Phase("flush", rec.phase("flush", flushErr), PostStop()),
+			Phase("serve", rec.phase("serve", serveErr)),
+			Phase("deregister", rec.phase("deregister", nil), PostStop()),
+		).Run(context.Background())
+		require.ErrorIs(t, err, serveErr)
+		require.ErrorIs(t, err, flushErr)
+		require.ErrorContains(t, err, `phase "serve"`)
+		require.ErrorContains(t, err, `phase "flush"`)
+		require.Equal(t, []string{"serve", "flush", "deregister"}, rec.Ran())
+	})
+
+	t.Run("recovers panics as phase errors", func(t *testing.T) {
+		var rec phaseRecorder
+
+		err := Sequence(
+			Phase("migrate", RuntimeFunc(func(ctx context.Context) error {
+				panic("boom")
+			})),
+			Phase("cleanup", rec.phase("cleanup", nil), PostStop()),
+		).Run(context.Background())
+		require.ErrorContains(t, err, `phase "migrate": recovered from panic: boom`)
+
+		var panicErr *PanicError
+		require.ErrorAs(t, err, &panicErr)
+		require.Equal(t, []string{"cleanup"}, rec.Ran())
+	})
+
+	t.Run("bounds post-stop phases by the shutdown deadline", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		rt := Sequence(
+			Phase("serve", RuntimeFunc(func(ctx context.Context) error {
+				cancel()
+				<-ctx.Done()
+				return nil
+			})),
+			Phase("drain", RuntimeFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return context.Cause(ctx)
+			}), PostStop()),
+		)
+
+		err := GracefulShutdown(DefaultRunner[Runtime](), 50*time.Millisecond).Run(ctx, BuilderOf(rt))
+		require.ErrorIs(t, err, ErrShutdownTimeout)
+	})
+}
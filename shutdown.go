@@ -0,0 +1,147 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+)
+
+// ErrShutdownTimeout is returned by [GracefulShutdown] when the application does not
+// return within the shutdown timeout. It is also the cause of the context returned by
+// [ShutdownContext] once the deadline passes.
+var ErrShutdownTimeout = errors.New("shutdown timed out")
+
+// ErrShutdownAborted is returned by [GracefulShutdown] when a second signal is
+// received while the application is shutting down.
+var ErrShutdownAborted = errors.New("shutdown aborted")
+
+// shutdownState tracks the deadline shared by everything shutting down within
+// a GracefulShutdown Runner.
+type shutdownState struct {
+	timeout time.Duration
+
+	once     sync.Once
+	deadline time.Time
+
+	abortCtx context.Context
+	abort    context.CancelCauseFunc
+}
+
+// begin starts the shutdown, if it has not already started, and returns its deadline.
+func (s *shutdownState) begin() time.Time {
+	s.once.Do(func() {
+		s.deadline = time.Now().Add(s.timeout)
+	})
+	return s.deadline
+}
+
+type shutdownStateKey struct{}
+
+// GracefulShutdown wraps a Runner to bound how long the application may take to shut down.
+//
+// Shutdown begins when one of the given signals is received or ctx is cancelled, at which
+// point the context passed to runner is cancelled. If the application has not returned
+// within timeout, GracefulShutdown returns [ErrShutdownTimeout] without waiting for it.
+// A second signal received while shutting down returns [ErrShutdownAborted] immediately.
+//
+// Runtimes should use [ShutdownContext] for their own cleanup so that it fits within
+// the same deadline. A panic from runner is re-raised on the calling goroutine as a
+// *PanicError, which keeps the original stack, so [RecoverPanics] may wrap GracefulShutdown.
+func GracefulShutdown[T Runtime](runner Runner[T], timeout time.Duration, signals ...os.Signal) Runner[T] {
+	return RunnerFunc[T](func(ctx context.Context, builder Builder[T]) error {
+		sigCh := make(chan os.Signal, 2)
+		if len(signals) > 0 {
+			signal.Notify(sigCh, signals...)
+			defer signal.Stop(sigCh)
+		}
+
+		state := &shutdownState{timeout: timeout}
+		state.abortCtx, state.abort = context.WithCancelCause(context.Background())
+		defer state.abort(nil)
+
+		runCtx, cancel := context.WithCancel(context.WithValue(ctx, shutdownStateKey{}, state))
+		defer cancel()
+
+		type result struct {
+			err   error
+			panic any
+		}
+		resCh := make(chan result, 1)
+		go func() {
+			var res result
+			defer func() {
+				if r := recover(); r != nil {
+					res.panic = NewPanicError(r)
+				}
+				resCh <- res
+			}()
+			res.err = runner.Run(runCtx, builder)
+		}()
+
+		wait := func(res result) error {
+			if res.panic != nil {
+				panic(res.panic)
+			}
+			return res.err
+		}
+
+		select {
+		case res := <-resCh:
+			return wait(res)
+		case <-sigCh:
+		case <-runCtx.Done():
+		}
+
+		deadline := state.begin()
+		cancel()
+
+		timer := time.NewTimer(time.Until(deadline))
+		defer timer.Stop()
+
+		select {
+		case res := <-resCh:
+			return wait(res)
+		case <-timer.C:
+			state.abort(ErrShutdownTimeout)
+			return ErrShutdownTimeout
+		case <-sigCh:
+			state.abort(ErrShutdownAborted)
+			return ErrShutdownAborted
+		}
+	})
+}
+
+// ShutdownContext returns a context for cleanup work performed once ctx is cancelled,
+// such as draining connections or flushing telemetry. The returned context keeps the
+// values of ctx but is not cancelled with it. It should only be called once ctx is
+// done, since calling it starts the shutdown deadline.
+//
+// Within a [GracefulShutdown] Runner, the returned context expires at the shutdown
+// deadline with [ErrShutdownTimeout] as its cause, or earlier if the shutdown is aborted.
+// Otherwise, it is only cancelled by calling the returned cancel function.
+func ShutdownContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	state, ok := ctx.Value(shutdownStateKey{}).(*shutdownState)
+	if !ok {
+		return context.WithCancel(context.WithoutCancel(ctx))
+	}
+
+	abortCtx, abort := context.WithCancelCause(context.WithoutCancel(ctx))
+	stop := context.AfterFunc(state.abortCtx, func() {
+		abort(context.Cause(state.abortCtx))
+	})
+
+	deadlineCtx, cancel := context.WithDeadlineCause(abortCtx, state.begin(), ErrShutdownTimeout)
+	return deadlineCtx, func() {
+		stop()
+		cancel()
+		abort(context.Canceled)
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGracefulShutdown(t *testing.T) {
+	t.Run("returns the runtime error when it finishes on its own", func(t *testing.T) {
+		runErr := errors.New("failed")
+		runner := GracefulShutdown(DefaultRunner[Runtime](), time.Second)
+
+		err := runner.Run(context.Background(), BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			return runErr
+		})))
+		require.Equal(t, runErr, err)
+	})
+
+	t.Run("waits for the runtime to shut down within the timeout", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		runner := GracefulShutdown(DefaultRunner[Runtime](), time.Second)
+
+		err := runner.Run(ctx, BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		})))
+		require.NoError(t, err)
+	})
+
+	t.Run("returns ErrShutdownTimeout when the runtime does not shut down in time", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		stuck := make(chan struct{})
+		defer close(stuck)
+
+		runner := GracefulShutdown(DefaultRunner[Runtime](), 10*time.Millisecond)
+
+		err := runner.Run(ctx, BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			<-stuck
+			return nil
+		})))
+		require.Equal(t, ErrShutdownTimeout, err)
+	})
+
+	t.Run("re-raises panics", func(t *testing.T) {
+		runner := RecoverPanics(GracefulShutdown(DefaultRunner[Runtime](), time.Second))
+
+		err := runner.Run(context.Background(), BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			panic("boom")
+		})))
+		require.ErrorContains(t, err, "recovered from panic: boom")
+	})
+
+	t.Run("starts shutting down on the first signal", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("signals work differently on Windows")
+		}
+
+		runner := GracefulShutdown(DefaultRunner[Runtime](), signalWaitTimeout, syscall.SIGUSR1)
+
+		go func() {
+			quiesce()
+			syscall.Kill(syscall.Getpid(), syscall.SIGUSR1)
+		}()
+
+		err := runner.Run(context.Background(), BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		})))
+		require.NoError(t, err)
+	})
+
+	t.Run("aborts on the second signal", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("signals work differently on Windows")
+		}
+
+		stuck := make(chan struct{})
+		defer close(stuck)
+
+		runner := GracefulShutdown(DefaultRunner[Runtime](), time.Hour, syscall.SIGUSR1)
+
+		go func() {
+			quiesce()
+			syscall.Kill(syscall.Getpid(), syscall.SIGUSR1)
+			quiesce()
+			syscall.Kill(syscall.Getpid(), syscall.SIGUSR1)
+		}()
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- runner.Run(context.Background(), BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+				<-stuck
+				return nil
+			})))
+		}()
+
+		select {
+		case err := <-errCh:
+			require.Equal(t, ErrShutdownAborted, err)
+		case <-time.After(signalWaitTimeout):
+			t.Fatal("runner did not abort")
+		}
+	})
+}
+
+func TestShutdownContext(t *testing.T) {
+	t.Run("is not cancelled with ctx", func(t *testing.T) {
+		type key struct{}
+		ctx, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "value"))
+		cancel()
+
+		shutdownCtx, cancelShutdown := ShutdownContext(ctx)
+		defer cancelShutdown()
+
+		require.NoError(t, shutdownCtx.Err())
+		require.Equal(t, "value", shutdownCtx.Value(key{}))
+
+		_, ok := shutdownCtx.Deadline()
+		require.False(t, ok)
+	})
+
+	t.Run("expires at the shutdown deadline", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		runner := GracefulShutdown(DefaultRunner[Runtime](), time.Minute)
+
+		var deadline time.Time
+		err := runner.Run(ctx, BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+
+			shutdownCtx, cancel := ShutdownContext(ctx)
+			defer cancel()
+
+			var ok bool
+			deadline, ok = shutdownCtx.Deadline()
+			require.True(t, ok)
+			return nil
+		})))
+		require.NoError(t, err)
+		require.WithinDuration(t, time.Now().Add(time.Minute), deadline, 5*time.Second)
+	})
+
+	t.Run("is cancelled when the shutdown times out", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		runner := GracefulShutdown(DefaultRunner[Runtime](), 10*time.Millisecond)
+
+		causeCh := make(chan error, 1)
+		err := runner.Run(ctx, BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+
+			shutdownCtx, cancel := ShutdownContext(ctx)
+			defer cancel()
+
+			<-shutdownCtx.Done()
+			causeCh <- context.Cause(shutdownCtx)
+			time.Sleep(time.Second)
+			return nil
+		})))
+		require.Equal(t, ErrShutdownTimeout, err)
+		require.Equal(t, ErrShutdownTimeout, <-causeCh)
+	})
+}
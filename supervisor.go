@@ -0,0 +1,187 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrRestartLimitExceeded is returned, joined with the errors which caused the
+// restarts, when a supervised Runtime fails more often than its RestartPolicy allows.
+var ErrRestartLimitExceeded = errors.New("restart limit exceeded")
+
+// Backoff returns how long to wait before the given restart, starting at 1.
+type Backoff func(restart int) time.Duration
+
+// ConstantBackoff returns a Backoff which always waits d.
+func ConstantBackoff(d time.Duration) Backoff {
+	return func(int) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff returns a Backoff which doubles the wait, starting at initial,
+// for each consecutive restart up to limit. A random jitter of up to half the wait
+// is subtracted so that many restarting Runtimes do not retry in lockstep.
+func ExponentialBackoff(initial, limit time.Duration) Backoff {
+	return func(restart int) time.Duration {
+		d := initial
+		for i := 1; i < restart && d < limit; i++ {
+			d *= 2
+		}
+		d = min(d, limit)
+		if d <= 0 {
+			return 0
+		}
+
+		half := d / 2
+		return d - half + rand.N(half+1)
+	}
+}
+
+// RestartPolicy describes when and how a failed Runtime is restarted.
+type RestartPolicy struct {
+	// MaxRestarts is the maximum number of restarts allowed within Window.
+	// A negative value allows unlimited restarts.
+	MaxRestarts int
+
+	// Window is the period over which restarts are counted. Failures older
+	// than Window are forgotten, which also resets Backoff. A zero Window
+	// counts every restart.
+	Window time.Duration
+
+	// Backoff determines how long to wait before each restart. A nil Backoff
+	// restarts immediately.
+	Backoff Backoff
+
+	// OnRestart, if set, is called before each restart with the total number
+	// of restarts so far and the error which caused the restart.
+	OnRestart func(ctx context.Context, restarts int, err error)
+}
+
+// restarter implements a RestartPolicy.
+type restarter struct {
+	policy   RestartPolicy
+	restarts atomic.Int64
+
+	mu       sync.Mutex
+	failures []time.Time
+	errs     []error
+}
+
+func newRestarter(policy RestartPolicy) *restarter {
+	return &restarter{policy: policy}
+}
+
+// fail records err and reports how many failures have occurred within the window,
+// or an error if the policy does not allow another restart.
+func (r *restarter) fail(err error) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if r.policy.Window > 0 {
+		i := 0
+		for i < len(r.failures) && now.Sub(r.failures[i]) > r.policy.Window {
+			i++
+		}
+		r.failures = r.failures[i:]
+		r.errs = r.errs[i:]
+	}
+	r.failures = append(r.failures, now)
+	r.errs = append(r.errs, err)
+
+	n := len(r.failures)
+	if r.policy.MaxRestarts >= 0 && n > r.policy.MaxRestarts {
+		errs := r.errs
+		r.failures = nil
+		r.errs = nil
+		return n, fmt.Errorf("%w: %d failures: %w", ErrRestartLimitExceeded, n, errors.Join(errs...))
+	}
+	return n, nil
+}
+
+// run calls f until it succeeds, ctx is done or the policy gives up.
+func (r *restarter) run(ctx context.Context, f func(context.Context) error) error {
+	for {
+		err := f(ctx)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+
+		n, limitErr := r.fail(err)
+		if limitErr != nil {
+			return limitErr
+		}
+
+		restarts := int(r.restarts.Add(1))
+		if r.policy.OnRestart != nil {
+			r.policy.OnRestart(ctx, restarts, err)
+		}
+
+		if r.policy.Backoff == nil {
+			continue
+		}
+		timer := time.NewTimer(r.policy.Backoff(n))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// Supervisor is a Runner which rebuilds and restarts a failed Runtime
+// according to a RestartPolicy.
+type Supervisor[T Runtime] struct {
+	runner    Runner[T]
+	restarter *restarter
+}
+
+// Supervise wraps runner so the application is rebuilt and restarted whenever
+// building or running it fails. Restarting stops once ctx is done, the Runtime
+// returns without an error, or the policy gives up, in which case an error
+// wrapping [ErrRestartLimitExceeded] and every error within the window is returned.
+//
+// When the Runtime is a [Group], a failing member stops and restarts the whole
+// Group (one-for-all). To restart only the failed member (one-for-one), give
+// the member a policy with [RestartMember] instead.
+//
+// Panics are only restarted if runner recovers them, e.g. with [RecoverPanics].
+func Supervise[T Runtime](runner Runner[T], policy RestartPolicy) *Supervisor[T] {
+	return &Supervisor[T]{
+		runner:    runner,
+		restarter: newRestarter(policy),
+	}
+}
+
+// Run implements the [Runner] interface.
+func (s *Supervisor[T]) Run(ctx context.Context, builder Builder[T]) error {
+	return s.restarter.run(ctx, func(ctx context.Context) error {
+		return s.runner.Run(ctx, builder)
+	})
+}
+
+// Restarts returns the number of times the Runtime has been restarted.
+func (s *Supervisor[T]) Restarts() int {
+	return int(s.restarter.restarts.Load())
+}
+
+// RestartMember restarts the member of a [Group] according to policy when it
+// fails, panics or exits unexpectedly, without affecting the other members.
+// The member only fails the Group once the policy gives up.
+func RestartMember(policy RestartPolicy) MemberOption {
+	return func(m *GroupMember) {
+		m.restarter = newRestarter(policy)
+	}
+}
@@ -0,0 +1,287 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(100*time.Millisecond, time.Second)
+
+	testCases := []struct {
+		restart int
+		max     time.Duration
+	}{
+		{restart: 1, max: 100 * time.Millisecond},
+		{restart: 2, max: 200 * time.Millisecond},
+		{restart: 3, max: 400 * time.Millisecond},
+		{restart: 4, max: 800 * time.Millisecond},
+		{restart: 5, max: time.Second},
+		{restart: 50, max: time.Second},
+	}
+
+	for _, tc := range testCases {
+		for range 10 {
+			d := backoff(tc.restart)
+			require.LessOrEqual(t, d, tc.max)
+			require.GreaterOrEqual(t, d, tc.max/2)
+		}
+	}
+}
+
+func TestSupervise(t *testing.T) {
+	t.Run("rebuilds and restarts a failed runtime", func(t *testing.T) {
+		var builds atomic.Int64
+		builder := BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			n := builds.Add(1)
+			return RuntimeFunc(func(ctx context.Context) error {
+				if n < 3 {
+					return errors.New("broker unavailable")
+				}
+				return nil
+			}), nil
+		})
+
+		var restarts []int
+		s := Supervise(DefaultRunner[Runtime](), RestartPolicy{
+			MaxRestarts: 5,
+			OnRestart: func(ctx context.Context, n int, err error) {
+				restarts = append(restarts, n)
+			},
+		})
+
+		err := s.Run(context.Background(), builder)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), builds.Load())
+		require.Equal(t, 2, s.Restarts())
+		require.Equal(t, []int{1, 2}, restarts)
+	})
+
+	t.Run("gives up once the restart limit is exceeded", func(t *testing.T) {
+		err1 := errors.New("error 1")
+		err2 := errors.New("error 2")
+		err3 := errors.New("error 3")
+		errs := []error{err1, err2, err3}
+
+		var runs atomic.Int64
+		rt := RuntimeFunc(func(ctx context.Context) error {
+			return errs[runs.Add(1)-1]
+		})
+
+		s := Supervise(DefaultRunner[Runtime](), RestartPolicy{MaxRestarts: 2})
+
+		err := s.Run(context.Background(), BuilderOf[Runtime](rt))
+		require.ErrorIs(t, err, ErrRestartLimitExceeded)
+		require.ErrorIs(t, err, err1)
+		require.ErrorIs(t, err, err2)
+		require.ErrorIs(t, err, err3)
+		require.Equal(t, 2, s.Restarts())
+	})
+
+	t.Run("forgets failures outside of the window", func(t *testing.T) {
+		var runs atomic.Int64
+		rt := RuntimeFunc(func(ctx context.Context) error {
+			if runs.Add(1) > 3 {
+				return nil
+			}
+			time.Sleep(20 * time.Millisecond)
+			return errors.New("failed")
+		})
+
+		s := Supervise(DefaultRunner[Runtime](), RestartPolicy{
+			MaxRestarts: 1,
+			Window:      10 * time.Millisecond,
+		})
+
+		err := s.Run(context.Background(), BuilderOf[Runtime](rt))
+		require.NoError(t, err)
+		require.Equal(t, 3, s.Restarts())
+	})
+
+	t.Run("does not restart a runtime which returns without error", func(t *testing.T) {
+		s := Supervise(DefaultRunner[Runtime](), RestartPolicy{MaxRestarts: -1})
+
+		err := s.Run(context.Background(), BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			return nil
+		})))
+		require.NoError(t, err)
+		require.Zero(t, s.Restarts())
+	})
+
+	t.Run("stops restarting once the context is cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		runErr := errors.New("failed")
+
+		s := Supervise(DefaultRunner[Runtime](), RestartPolicy{
+			MaxRestarts: -1,
+			Backoff:     ConstantBackoff(time.Hour),
+			OnRestart: func(ctx context.Context, restarts int, err error) {
+				cancel()
+			},
+		})
+
+		err := s.Run(ctx, BuilderOf[Runtime](RuntimeFunc(func(ctx context.Context) error {
+			return runErr
+		})))
+		require.Equal(t, runErr, err)
+		require.Equal(t, 1, s.Restarts())
+	})
+
+	t.Run("restarts after recovered panics", func(t *testing.T) {
+		var runs atomic.Int64
+		rt := RuntimeFunc(func(ctx context.Context) error {
+			if runs.Add(1) == 1 {
+				panic("boom")
+			}
+			return nil
+		})
+
+		s := Supervise(RecoverPanics(DefaultRunner[Runtime]()), RestartPolicy{MaxRestarts: 1})
+
+		err := s.Run(context.Background(), BuilderOf[Runtime](rt))
+		require.NoError(t, err)
+		require.Equal(t, 1, s.Restarts())
+	})
+
+	t.Run("restarts the whole group when a member fails", func(t *testing.T) {
+		var builds atomic.Int64
+		builder := BuilderFunc[Runtime](func(ctx context.Context) (Runtime, error) {
+			n := builds.Add(1)
+			return Group(
+				Member("api", blockingRuntime()),
+				Member("consumer", RuntimeFunc(func(ctx context.Context) error {
+					if n == 1 {
+						return errors.New("broker unavailable")
+					}
+					return nil
+				}), AllowExit()),
+			), nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s := Supervise(DefaultRunner[Runtime](), RestartPolicy{
+			MaxRestarts: 1,
+			OnRestart: func(ctx context.Context, restarts int, err error) {
+				var memberErr *MemberError
+				require.ErrorAs(t, err, &memberErr)
+				require.Equal(t, "consumer", memberErr.Name)
+			},
+		})
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- s.Run(ctx, builder)
+		}()
+
+		require.Eventually(t, func() bool {
+			return builds.Load() == 2
+		}, 5*time.Second, time.Millisecond)
+		cancel()
+
+		require.NoError(t, <-errCh)
+		require.Equal(t, 1, s.Restarts())
+	})
+}
+
+func TestRestartMember(t *testing.T) {
+	t.Run("restarts only the failed member", func(t *testing.T) {
+		var apiRuns, consumerRuns atomic.Int64
+		api := RuntimeFunc(func(ctx context.Context) error {
+			apiRuns.Add(1)
+			<-ctx.Done()
+			return nil
+		})
+		consumer := RuntimeFunc(func(ctx context.Context) error {
+			if consumerRuns.Add(1) < 3 {
+				return errors.New("broker unavailable")
+			}
+			<-ctx.Done()
+			return nil
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- Group(
+				Member("api", api),
+				Member("consumer", consumer, RestartMember(RestartPolicy{MaxRestarts: 5})),
+			).Run(ctx)
+		}()
+
+		require.Eventually(t, func() bool {
+			return consumerRuns.Load() == 3
+		}, 5*time.Second, time.Millisecond)
+		cancel()
+
+		require.NoError(t, <-errCh)
+		require.Equal(t, int64(1), apiRuns.Load())
+	})
+
+	t.Run("restarts members which exit unexpectedly or panic", func(t *testing.T) {
+		var runs atomic.Int64
+		consumer := RuntimeFunc(func(ctx context.Context) error {
+			switch runs.Add(1) {
+			case 1:
+				return nil
+			case 2:
+				panic("boom")
+			default:
+				<-ctx.Done()
+				return nil
+			}
+		})
+
+		var restartErrs []error
+		policy := RestartPolicy{
+			MaxRestarts: 5,
+			OnRestart: func(ctx context.Context, restarts int, err error) {
+				restartErrs = append(restartErrs, err)
+			},
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- Group(
+				Member("consumer", consumer, RestartMember(policy)),
+			).Run(ctx)
+		}()
+
+		require.Eventually(t, func() bool {
+			return runs.Load() == 3
+		}, 5*time.Second, time.Millisecond)
+		cancel()
+
+		require.NoError(t, <-errCh)
+		require.Len(t, restartErrs, 2)
+		require.ErrorIs(t, restartErrs[0], ErrUnexpectedExit)
+		require.ErrorContains(t, restartErrs[1], "recovered from panic: boom")
+	})
+
+	t.Run("fails the group once the policy gives up", func(t *testing.T) {
+		runErr := errors.New("broker unavailable")
+
+		err := Group(
+			Member("api", blockingRuntime()),
+			Member("consumer", RuntimeFunc(func(ctx context.Context) error {
+				return runErr
+			}), RestartMember(RestartPolicy{MaxRestarts: 2})),
+		).Run(context.Background())
+		require.ErrorIs(t, err, ErrRestartLimitExceeded)
+		require.ErrorIs(t, err, runErr)
+
+		var memberErr *MemberError
+		require.ErrorAs(t, err, &memberErr)
+		require.Equal(t, "consumer", memberErr.Name)
+	})
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/z5labs/bedrock/config"
+)
+
+// ChoiceError is returned by [Switch] when the configured choice does not
+// select any of its Builders.
+type ChoiceError struct {
+	// Choice is the configured choice.
+	Choice string
+
+	// Valid lists the valid choices in sorted order.
+	Valid []string
+}
+
+// Error implements the [error] interface.
+func (e *ChoiceError) Error() string {
+	quoted := make([]string, len(e.Valid))
+	for i, v := range e.Valid {
+		quoted[i] = strconv.Quote(v)
+	}
+	return fmt.Sprintf("unknown choice %q, must be one of: %s", e.Choice, strings.Join(quoted, ", "))
+}
+
+// Switch returns a Builder named name, see [Named], which reads the configuration
+// setting "choice" from r and builds only the Builder in cases selected by it, e.g.
+// to choose between implementations of a pluggable component:
+//
+//	exporter := bedrock.Switch("SpanExporter", config.Default("otlp-grpc", config.Env("SPAN_EXPORTER")), map[string]bedrock.Builder[trace.SpanExporter]{
+//		"otlp-grpc": otlpGRPCExporter,
+//		"otlp-http": otlpHTTPExporter,
+//		"stdout":    stdoutExporter,
+//	})
+//
+// The choice is read with [ReadConfig], so it is recorded in a config.Report and,
+// in dry run mode, its errors are recorded instead of returned. If the setting cannot
+// be read, or does not select any of the cases, the error is returned as a
+// *[BuildError] for name and the key "choice". In the latter case it wraps a
+// *[ChoiceError] which lists the valid choices. Use [config.Default] to fall back to
+// a choice when the setting is not set.
+func Switch[K comparable, T any](name string, r config.Reader[K], cases map[K]Builder[T]) Builder[T] {
+	return Named(name, BuilderFunc[T](func(ctx context.Context) (T, error) {
+		var zero T
+		val, ok, err := readConfig(ctx, "choice", r)
+		if err != nil {
+			return zero, WrapBuildError(name, err)
+		}
+		if !ok {
+			// The error has already been recorded in dry run mode.
+			return zero, nil
+		}
+
+		choice, _ := val.Value()
+		b, ok := cases[choice]
+		if !ok {
+			err := withSource(val.Source(), newChoiceError(choice, cases))
+			return zero, WrapBuildError(name, configError(ctx, "choice", err))
+		}
+		return b.Build(ctx)
+	}))
+}
+
+func newChoiceError[K comparable, T any](choice K, cases map[K]Builder[T]) *ChoiceError {
+	valid := make([]string, 0, len(cases))
+	for k := range maps.Keys(cases) {
+		valid = append(valid, fmt.Sprint(k))
+	}
+	slices.Sort(valid)
+
+	return &ChoiceError{
+		Choice: fmt.Sprint(choice),
+		Valid:  valid,
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/z5labs/bedrock/config"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSwitch(t *testing.T) {
+	unbuildable := BuilderFunc[string](func(ctx context.Context) (string, error) {
+		t.Fatal("unselected builder should not be built")
+		return "", nil
+	})
+
+	t.Run("builds only the selected builder", func(t *testing.T) {
+		b := Switch("Exporter", config.ReaderOf("stdout"), map[string]Builder[string]{
+			"otlp":   unbuildable,
+			"stdout": BuilderOf("stdout exporter"),
+		})
+
+		v, err := b.Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "stdout exporter", v)
+	})
+
+	t.Run("supports any comparable key", func(t *testing.T) {
+		b := Switch("Version", config.ReaderOf(2), map[int]Builder[string]{
+			1: unbuildable,
+			2: BuilderOf("v2"),
+		})
+
+		v, err := b.Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "v2", v)
+	})
+
+	t.Run("falls back to the default choice", func(t *testing.T) {
+		b := Switch("Exporter", config.Default("noop", config.EmptyReader[string]()), map[string]Builder[string]{
+			"noop":   BuilderOf("noop exporter"),
+			"stdout": unbuildable,
+		})
+
+		v, err := b.Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "noop exporter", v)
+	})
+
+	t.Run("lists the valid choices for an unknown choice", func(t *testing.T) {
+		b := Switch("Exporter", config.ReaderOf("zipkin"), map[string]Builder[string]{
+			"stdout": unbuildable,
+			"noop":   unbuildable,
+			"otlp":   unbuildable,
+		})
+
+		_, err := b.Build(context.Background())
+
+		var choiceErr *ChoiceError
+		require.ErrorAs(t, err, &choiceErr)
+		require.Equal(t, "zipkin", choiceErr.Choice)
+		require.Equal(t, []string{"noop", "otlp", "stdout"}, choiceErr.Valid)
+
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"Exporter"}, buildErr.Path)
+		require.Equal(t, "choice", buildErr.Key)
+		require.EqualError(t, err, `build Exporter: config "choice": unknown choice "zipkin", must be one of: "noop", "otlp", "stdout"`)
+	})
+
+	t.Run("returns a config error when the choice is not set", func(t *testing.T) {
+		b := Switch("Exporter", config.EmptyReader[string](), map[string]Builder[string]{
+			"stdout": unbuildable,
+		})
+
+		_, err := b.Build(context.Background())
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"Exporter"}, buildErr.Path)
+		require.Equal(t, "choice", buildErr.Key)
+	})
+
+	t.Run("returns the error of the selected builder", func(t *testing.T) {
+		buildErr := errors.New("failed")
+		b := Switch("Exporter", config.ReaderOf("otlp"), map[string]Builder[string]{
+			"otlp": BuilderFunc[string](func(ctx context.Context) (string, error) {
+				return "", buildErr
+			}),
+		})
+
+		_, err := b.Build(context.Background())
+		require.Equal(t, buildErr, err)
+	})
+
+	t.Run("records an unknown choice in dry run mode", func(t *testing.T) {
+		b := Map(Switch("Exporter", config.ReaderOf("zipkin"), map[string]Builder[string]{
+			"otlp": unbuildable,
+		}), func(ctx context.Context, s string) (Runtime, error) {
+			return RuntimeFunc(func(ctx context.Context) error { return nil }), nil
+		})
+
+		err := DryRunner[Runtime]().Run(context.Background(), b)
+
+		var choiceErr *ChoiceError
+		require.ErrorAs(t, err, &choiceErr)
+		require.Equal(t, []string{"otlp"}, choiceErr.Valid)
+
+		var buildErr *BuildError
+		require.ErrorAs(t, err, &buildErr)
+		require.Equal(t, []string{"Exporter"}, buildErr.Path)
+	})
+
+	t.Run("records an unset choice once in dry run mode", func(t *testing.T) {
+		b := Map(Switch("Exporter", config.EmptyReader[string](), map[string]Builder[string]{
+			"otlp": unbuildable,
+		}), func(ctx context.Context, s string) (Runtime, error) {
+			return RuntimeFunc(func(ctx context.Context) error { return nil }), nil
+		})
+
+		err := DryRunner[Runtime]().Run(context.Background(), b)
+		require.ErrorIs(t, err, config.ErrValueNotSet)
+
+		var choiceErr *ChoiceError
+		require.False(t, errors.As(err, &choiceErr))
+	})
+
+	t.Run("records the choice in the config report", func(t *testing.T) {
+		t.Setenv("TEST_SWITCH_EXPORTER", "stdout")
+
+		ctx, report := config.WithReport(context.Background())
+		b := Switch("Exporter", config.Env("TEST_SWITCH_EXPORTER"), map[string]Builder[string]{
+			"stdout": BuilderOf("stdout exporter"),
+		})
+
+		_, err := b.Build(ctx)
+		require.NoError(t, err)
+		require.Equal(t, []config.ReportEntry{
+			{Key: "Exporter.choice", Value: "stdout", Source: config.Source{Kind: config.SourceEnv, Name: "TEST_SWITCH_EXPORTER"}},
+		}, report.Entries())
+	})
+}
@@ -0,0 +1,24 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+// Package systemd integrates bedrock applications with the systemd service manager.
+//
+// Notify wraps a bedrock.Runner to speak the sd_notify protocol over the socket named
+// by the NOTIFY_SOCKET environment variable, which systemd sets for services with
+// Type=notify:
+//
+//	runner := systemd.Notify(bedrock.DefaultRunner[bedrock.Runtime]())
+//
+// READY=1 is sent once the Runtime reports that it is ready with bedrock.Ready, and
+// STOPPING=1 once the context passed to it is cancelled. Runtimes can send their own
+// status with Status.
+//
+// # Watchdog
+//
+// When the service sets WatchdogSec=, systemd passes the interval in WATCHDOG_USEC and
+// Notify sends WATCHDOG=1 keepalives at half of that interval until the Runtime returns.
+//
+// Outside of systemd, NOTIFY_SOCKET is not set and Notify has no effect.
+package systemd
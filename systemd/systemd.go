@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package systemd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/z5labs/bedrock"
+)
+
+// notifier sends sd_notify messages to the service manager.
+type notifier struct {
+	mu   sync.Mutex
+	conn *net.UnixConn
+}
+
+func (n *notifier) send(state string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	_, err := n.conn.Write([]byte(state))
+	return err
+}
+
+type notifierKey struct{}
+
+// Notify wraps runner to report the state of the application to systemd using the
+// sd_notify protocol. It has no effect unless NOTIFY_SOCKET is set.
+//
+// READY=1 is sent once the Runtime reports that it is ready, see bedrock.Ready, and
+// STOPPING=1 once ctx is cancelled. If WATCHDOG_USEC is set, and WATCHDOG_PID is either
+// unset or the PID of the current process, WATCHDOG=1 is sent at half the watchdog
+// interval until the Runtime returns.
+//
+// Notify returns an error without running the application if NOTIFY_SOCKET is set but
+// cannot be connected to. Failing to send a notification is otherwise ignored.
+func Notify[T bedrock.Runtime](runner bedrock.Runner[T]) bedrock.Runner[T] {
+	return bedrock.RunnerFunc[T](func(ctx context.Context, builder bedrock.Builder[T]) error {
+		socket := os.Getenv("NOTIFY_SOCKET")
+		if socket == "" {
+			return runner.Run(ctx, builder)
+		}
+
+		conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: socket, Net: "unixgram"})
+		if err != nil {
+			return fmt.Errorf("systemd: connecting to notify socket: %w", err)
+		}
+		defer conn.Close()
+
+		n := &notifier{conn: conn}
+
+		done := make(chan struct{})
+		var wg sync.WaitGroup
+		defer wg.Wait()
+		defer close(done)
+
+		outer := ctx
+		ctx, ready := bedrock.NotifyReady(context.WithValue(ctx, notifierKey{}, n))
+		wg.Go(func() {
+			select {
+			case <-done:
+				return
+			case <-ctx.Done():
+				n.send("STOPPING=1")
+				return
+			case <-ready:
+				n.send("READY=1")
+				bedrock.Ready(outer)
+			}
+
+			select {
+			case <-done:
+			case <-ctx.Done():
+				n.send("STOPPING=1")
+			}
+		})
+
+		if interval, ok := watchdogInterval(); ok {
+			wg.Go(func() {
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+
+				for {
+					select {
+					case <-done:
+						return
+					case <-ticker.C:
+						n.send("WATCHDOG=1")
+					}
+				}
+			})
+		}
+
+		return runner.Run(ctx, builder)
+	})
+}
+
+// watchdogInterval returns how often to send watchdog keepalives, if the
+// watchdog is enabled for the current process.
+func watchdogInterval() (time.Duration, bool) {
+	usec, err := strconv.ParseInt(os.Getenv("WATCHDOG_USEC"), 10, 64)
+	if err != nil || usec <= 0 {
+		return 0, false
+	}
+
+	if pid := os.Getenv("WATCHDOG_PID"); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return 0, false
+	}
+	return time.Duration(usec) * time.Microsecond / 2, true
+}
+
+// Status sends a free-form status message describing the state of the application,
+// which systemctl status shows alongside the service. It is a no-op unless ctx was
+// passed down from a Notify Runner.
+func Status(ctx context.Context, status string) error {
+	n, ok := ctx.Value(notifierKey{}).(*notifier)
+	if !ok {
+		return nil
+	}
+	return n.send("STATUS=" + status)
+}
@@ -0,0 +1,151 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package systemd
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/z5labs/bedrock"
+
+	"github.com/stretchr/testify/require"
+)
+
+// listenNotifySocket listens on a local unixgram socket and points NOTIFY_SOCKET at it.
+func listenNotifySocket(t *testing.T) *net.UnixConn {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("unixgram sockets are not supported on Windows")
+	}
+
+	path := filepath.Join(t.TempDir(), "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+
+	t.Setenv("NOTIFY_SOCKET", path)
+	t.Setenv("WATCHDOG_USEC", "")
+	t.Setenv("WATCHDOG_PID", "")
+	return conn
+}
+
+func readMessage(t *testing.T, conn *net.UnixConn) string {
+	t.Helper()
+
+	buf := make([]byte, 1024)
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	return string(buf[:n])
+}
+
+func TestNotify(t *testing.T) {
+	t.Run("sends READY, STATUS and STOPPING", func(t *testing.T) {
+		conn := listenNotifySocket(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		ctx, ready := bedrock.NotifyReady(ctx)
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- Notify(bedrock.DefaultRunner[bedrock.Runtime]()).Run(ctx, bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+				bedrock.Ready(ctx)
+				<-ctx.Done()
+				return Status(ctx, "draining")
+			})))
+		}()
+
+		require.Equal(t, "READY=1", readMessage(t, conn))
+		select {
+		case <-ready:
+		case <-time.After(5 * time.Second):
+			t.Fatal("readiness was not forwarded")
+		}
+
+		cancel()
+		messages := []string{readMessage(t, conn), readMessage(t, conn)}
+		require.ElementsMatch(t, []string{"STOPPING=1", "STATUS=draining"}, messages)
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("sends STOPPING when cancelled before ready", func(t *testing.T) {
+		conn := listenNotifySocket(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := Notify(bedrock.DefaultRunner[bedrock.Runtime]()).Run(ctx, bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+			time.Sleep(10 * time.Millisecond)
+			return nil
+		})))
+		require.NoError(t, err)
+		require.Equal(t, "STOPPING=1", readMessage(t, conn))
+	})
+
+	t.Run("sends watchdog keepalives", func(t *testing.T) {
+		conn := listenNotifySocket(t)
+		t.Setenv("WATCHDOG_USEC", "20000")
+		t.Setenv("WATCHDOG_PID", strconv.Itoa(os.Getpid()))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- Notify(bedrock.DefaultRunner[bedrock.Runtime]()).Run(ctx, bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+				<-ctx.Done()
+				return nil
+			})))
+		}()
+
+		require.Equal(t, "WATCHDOG=1", readMessage(t, conn))
+		require.Equal(t, "WATCHDOG=1", readMessage(t, conn))
+
+		cancel()
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("does not send keepalives for another process", func(t *testing.T) {
+		t.Setenv("WATCHDOG_USEC", "20000")
+		t.Setenv("WATCHDOG_PID", strconv.Itoa(os.Getpid()+1))
+
+		_, ok := watchdogInterval()
+		require.False(t, ok)
+	})
+
+	t.Run("has no effect without NOTIFY_SOCKET", func(t *testing.T) {
+		t.Setenv("NOTIFY_SOCKET", "")
+
+		var ran bool
+		err := Notify(bedrock.DefaultRunner[bedrock.Runtime]()).Run(context.Background(), bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+			ran = true
+			return Status(ctx, "ignored")
+		})))
+		require.NoError(t, err)
+		require.True(t, ran)
+	})
+
+	t.Run("fails when NOTIFY_SOCKET cannot be connected to", func(t *testing.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("unixgram sockets are not supported on Windows")
+		}
+		t.Setenv("NOTIFY_SOCKET", filepath.Join(t.TempDir(), "missing.sock"))
+
+		err := Notify(bedrock.DefaultRunner[bedrock.Runtime]()).Run(context.Background(), bedrock.BuilderOf[bedrock.Runtime](bedrock.RuntimeFunc(func(ctx context.Context) error {
+			t.Fatal("runtime should not be run")
+			return nil
+		})))
+		require.ErrorContains(t, err, "systemd: connecting to notify socket")
+	})
+}
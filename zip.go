@@ -0,0 +1,204 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+
+	"github.com/z5labs/bedrock/internal/fixedpool"
+)
+
+// buildTask returns a fixedpool.Task which builds b and stores the result in v.
+func buildTask[T any](b Builder[T], v *T) fixedpool.Task {
+	return func(ctx context.Context) error {
+		value, err := b.Build(ctx)
+		if err != nil {
+			return err
+		}
+		*v = value
+		return nil
+	}
+}
+
+// All returns a Builder which builds each of the given Builders concurrently
+// and returns their values in the same order as the Builders were given.
+//
+// The first Builder to fail cancels the context passed to the others. If a single
+// Builder fails its error is returned as is, otherwise the errors of all failed
+// Builders are joined. Panics are recovered and returned as errors.
+//
+// The context passed to the Builders is cancelled once All returns, so
+// Builders should not retain it.
+func All[T any](builders ...Builder[T]) Builder[[]T] {
+	return BuilderFunc[[]T](func(ctx context.Context) ([]T, error) {
+		values := make([]T, len(builders))
+		tasks := make([]fixedpool.Task, len(builders))
+		for i, b := range builders {
+			tasks[i] = buildTask(b, &values[i])
+		}
+
+		err := fixedpool.Wait(ctx, tasks...)
+		if err != nil {
+			return nil, err
+		}
+		return values, nil
+	})
+}
+
+// Zip2 returns a Builder which builds a and b concurrently and combines
+// their values using f. Errors are handled the same as [All].
+func Zip2[A, B, C any](
+	a Builder[A],
+	b Builder[B],
+	f func(context.Context, A, B) (C, error),
+) Builder[C] {
+	return BuilderFunc[C](func(ctx context.Context) (C, error) {
+		var (
+			va A
+			vb B
+		)
+		err := fixedpool.Wait(
+			ctx,
+			buildTask(a, &va),
+			buildTask(b, &vb),
+		)
+		if err != nil {
+			var zero C
+			return zero, err
+		}
+		return f(ctx, va, vb)
+	})
+}
+
+// Zip3 returns a Builder which builds a, b and c concurrently and combines
+// their values using f. Errors are handled the same as [All].
+func Zip3[A, B, C, D any](
+	a Builder[A],
+	b Builder[B],
+	c Builder[C],
+	f func(context.Context, A, B, C) (D, error),
+) Builder[D] {
+	return BuilderFunc[D](func(ctx context.Context) (D, error) {
+		var (
+			va A
+			vb B
+			vc C
+		)
+		err := fixedpool.Wait(
+			ctx,
+			buildTask(a, &va),
+			buildTask(b, &vb),
+			buildTask(c, &vc),
+		)
+		if err != nil {
+			var zero D
+			return zero, err
+		}
+		return f(ctx, va, vb, vc)
+	})
+}
+
+// Zip4 returns a Builder which builds a, b, c and d concurrently and combines
+// their values using f. Errors are handled the same as [All].
+func Zip4[A, B, C, D, E any](
+	a Builder[A],
+	b Builder[B],
+	c Builder[C],
+	d Builder[D],
+	f func(context.Context, A, B, C, D) (E, error),
+) Builder[E] {
+	return BuilderFunc[E](func(ctx context.Context) (E, error) {
+		var (
+			va A
+			vb B
+			vc C
+			vd D
+		)
+		err := fixedpool.Wait(
+			ctx,
+			buildTask(a, &va),
+			buildTask(b, &vb),
+			buildTask(c, &vc),
+			buildTask(d, &vd),
+		)
+		if err != nil {
+			var zero E
+			return zero, err
+		}
+		return f(ctx, va, vb, vc, vd)
+	})
+}
+
+// Zip5 returns a Builder which builds a, b, c, d and e concurrently and combines
+// their values using f. Errors are handled the same as [All].
+func Zip5[A, B, C, D, E, F any](
+	a Builder[A],
+	b Builder[B],
+	c Builder[C],
+	d Builder[D],
+	e Builder[E],
+	f func(context.Context, A, B, C, D, E) (F, error),
+) Builder[F] {
+	return BuilderFunc[F](func(ctx context.Context) (F, error) {
+		var (
+			va A
+			vb B
+			vc C
+			vd D
+			ve E
+		)
+		err := fixedpool.Wait(
+			ctx,
+			buildTask(a, &va),
+			buildTask(b, &vb),
+			buildTask(c, &vc),
+			buildTask(d, &vd),
+			buildTask(e, &ve),
+		)
+		if err != nil {
+			var zero F
+			return zero, err
+		}
+		return f(ctx, va, vb, vc, vd, ve)
+	})
+}
+
+// Zip6 returns a Builder which builds a, b, c, d, e and g concurrently and combines
+// their values using f. Errors are handled the same as [All].
+func Zip6[A, B, C, D, E, G, H any](
+	a Builder[A],
+	b Builder[B],
+	c Builder[C],
+	d Builder[D],
+	e Builder[E],
+	g Builder[G],
+	f func(context.Context, A, B, C, D, E, G) (H, error),
+) Builder[H] {
+	return BuilderFunc[H](func(ctx context.Context) (H, error) {
+		var (
+			va A
+			vb B
+			vc C
+			vd D
+			ve E
+			vg G
+		)
+		err := fixedpool.Wait(
+			ctx,
+			buildTask(a, &va),
+			buildTask(b, &vb),
+			buildTask(c, &vc),
+			buildTask(d, &vd),
+			buildTask(e, &ve),
+			buildTask(g, &vg),
+		)
+		if err != nil {
+			var zero H
+			return zero, err
+		}
+		return f(ctx, va, vb, vc, vd, ve, vg)
+	})
+}
@@ -0,0 +1,257 @@
+// Copyright (c) 2026 Z5Labs and Contributors
+//
+// This software is released under the MIT License.
+// https://opensource.org/licenses/MIT
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// barrierBuilder returns a Builder which only completes once n builders
+// sharing wg have started, so it deadlocks unless they run concurrently.
+func barrierBuilder[T any](wg *sync.WaitGroup, value T) Builder[T] {
+	return BuilderFunc[T](func(ctx context.Context) (T, error) {
+		wg.Done()
+
+		done := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+			return value, nil
+		case <-time.After(5 * time.Second):
+			var zero T
+			return zero, errors.New("builders did not run concurrently")
+		}
+	})
+}
+
+func TestAll(t *testing.T) {
+	t.Run("returns values in order", func(t *testing.T) {
+		b := All(BuilderOf(1), BuilderOf(2), BuilderOf(3))
+
+		values, err := b.Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []int{1, 2, 3}, values)
+	})
+
+	t.Run("builds nothing when given no builders", func(t *testing.T) {
+		values, err := All[int]().Build(context.Background())
+		require.NoError(t, err)
+		require.Empty(t, values)
+	})
+
+	t.Run("builds concurrently", func(t *testing.T) {
+		var wg sync.WaitGroup
+		wg.Add(3)
+
+		b := All(
+			barrierBuilder(&wg, "a"),
+			barrierBuilder(&wg, "b"),
+			barrierBuilder(&wg, "c"),
+		)
+
+		values, err := b.Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b", "c"}, values)
+	})
+
+	t.Run("cancels siblings when a builder fails", func(t *testing.T) {
+		buildErr := errors.New("dial failed")
+		siblingCancelled := make(chan struct{})
+
+		b := All(
+			BuilderFunc[int](func(ctx context.Context) (int, error) {
+				return 0, buildErr
+			}),
+			BuilderFunc[int](func(ctx context.Context) (int, error) {
+				select {
+				case <-ctx.Done():
+					close(siblingCancelled)
+					return 0, nil
+				case <-time.After(5 * time.Second):
+					return 0, errors.New("sibling was not cancelled")
+				}
+			}),
+		)
+
+		values, err := b.Build(context.Background())
+		require.Equal(t, buildErr, err)
+		require.Nil(t, values)
+
+		select {
+		case <-siblingCancelled:
+		default:
+			t.Fatal("expected sibling builder to observe cancellation")
+		}
+	})
+
+	t.Run("joins errors from multiple failed builders", func(t *testing.T) {
+		err1 := errors.New("error 1")
+		err2 := errors.New("error 2")
+
+		b := All(
+			BuilderFunc[int](func(ctx context.Context) (int, error) {
+				return 0, err1
+			}),
+			BuilderFunc[int](func(ctx context.Context) (int, error) {
+				return 0, err2
+			}),
+		)
+
+		_, err := b.Build(context.Background())
+		require.ErrorIs(t, err, err1)
+		require.ErrorIs(t, err, err2)
+	})
+
+	t.Run("keeps the build error path of each failed builder", func(t *testing.T) {
+		err1 := WrapBuildError("TCPListener", ConfigError("addr", errors.New("value not set")))
+		err2 := WrapBuildError("Handler", errors.New("error 2"))
+
+		b := All(
+			BuilderFunc[int](func(ctx context.Context) (int, error) {
+				return 0, err1
+			}),
+			BuilderFunc[int](func(ctx context.Context) (int, error) {
+				return 0, err2
+			}),
+		)
+
+		_, err := b.Build(context.Background())
+		err = WrapBuildError("http.Runtime", err)
+
+		joined, ok := err.(interface{ Unwrap() []error })
+		require.True(t, ok)
+
+		var paths [][]string
+		for _, e := range joined.Unwrap() {
+			var buildErr *BuildError
+			require.ErrorAs(t, e, &buildErr)
+			paths = append(paths, buildErr.Path)
+		}
+		require.ElementsMatch(t, [][]string{
+			{"http.Runtime", "TCPListener"},
+			{"http.Runtime", "Handler"},
+		}, paths)
+	})
+
+	t.Run("recovers panics as errors", func(t *testing.T) {
+		b := All(
+			BuilderFunc[int](func(ctx context.Context) (int, error) {
+				panic("boom")
+			}),
+		)
+
+		require.NotPanics(t, func() {
+			_, err := b.Build(context.Background())
+			require.ErrorContains(t, err, "boom")
+		})
+	})
+}
+
+func TestZip(t *testing.T) {
+	t.Run("Zip2 combines values", func(t *testing.T) {
+		b := Zip2(BuilderOf(1), BuilderOf("a"), func(ctx context.Context, i int, s string) (string, error) {
+			return fmt.Sprintf("%d%s", i, s), nil
+		})
+
+		v, err := b.Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "1a", v)
+	})
+
+	t.Run("Zip3 combines values", func(t *testing.T) {
+		b := Zip3(BuilderOf(1), BuilderOf("a"), BuilderOf(true), func(ctx context.Context, i int, s string, ok bool) (string, error) {
+			return fmt.Sprintf("%d%s%t", i, s, ok), nil
+		})
+
+		v, err := b.Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "1atrue", v)
+	})
+
+	t.Run("Zip4 combines values", func(t *testing.T) {
+		b := Zip4(BuilderOf(1), BuilderOf(2), BuilderOf(3), BuilderOf(4), func(ctx context.Context, a, b, c, d int) (int, error) {
+			return a + b + c + d, nil
+		})
+
+		v, err := b.Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 10, v)
+	})
+
+	t.Run("Zip5 combines values", func(t *testing.T) {
+		b := Zip5(BuilderOf(1), BuilderOf(2), BuilderOf(3), BuilderOf(4), BuilderOf(5), func(ctx context.Context, a, b, c, d, e int) (int, error) {
+			return a + b + c + d + e, nil
+		})
+
+		v, err := b.Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 15, v)
+	})
+
+	t.Run("Zip6 builds concurrently", func(t *testing.T) {
+		var wg sync.WaitGroup
+		wg.Add(6)
+
+		b := Zip6(
+			barrierBuilder(&wg, 1),
+			barrierBuilder(&wg, 2),
+			barrierBuilder(&wg, 3),
+			barrierBuilder(&wg, 4),
+			barrierBuilder(&wg, 5),
+			barrierBuilder(&wg, 6),
+			func(ctx context.Context, a, b, c, d, e, f int) (int, error) {
+				return a + b + c + d + e + f, nil
+			},
+		)
+
+		v, err := b.Build(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, 21, v)
+	})
+
+	t.Run("does not call f when a builder fails", func(t *testing.T) {
+		buildErr := errors.New("build failed")
+		called := false
+
+		b := Zip2(
+			BuilderOf(1),
+			BuilderFunc[string](func(ctx context.Context) (string, error) {
+				return "", buildErr
+			}),
+			func(ctx context.Context, i int, s string) (string, error) {
+				called = true
+				return s, nil
+			},
+		)
+
+		v, err := b.Build(context.Background())
+		require.Equal(t, buildErr, err)
+		require.Zero(t, v)
+		require.False(t, called)
+	})
+
+	t.Run("returns the error from f", func(t *testing.T) {
+		combineErr := errors.New("combine failed")
+
+		b := Zip2(BuilderOf(1), BuilderOf(2), func(ctx context.Context, a, b int) (int, error) {
+			return 0, combineErr
+		})
+
+		_, err := b.Build(context.Background())
+		require.ErrorIs(t, err, combineErr)
+	})
+}